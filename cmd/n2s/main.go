@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/shubhamrasal/n2s/internal/app"
+	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/spf13/cobra"
 )
 
@@ -14,9 +15,13 @@ var (
 	commit  = "none"
 	date    = "unknown"
 
-	natsURL    string
-	configPath string
-	readOnly   bool
+	natsURL     string
+	configPath  string
+	readOnly    bool
+	contextName string
+
+	importOpts  nats.ImportOptions
+	cleanupOpts app.CleanupOptions
 )
 
 var rootCmd = &cobra.Command{
@@ -36,12 +41,63 @@ var versionCmd = &cobra.Command{
 	},
 }
 
+var messagesCmd = &cobra.Command{
+	Use:   "messages",
+	Short: "Work with stream messages",
+}
+
+var messagesImportCmd = &cobra.Command{
+	Use:   "import <file.jsonl>",
+	Short: "Republish messages from a JSONL file",
+	Long: `Republish messages from a JSONL file (one message per line with subject,
+headers, base64 data and time) into a subject or stream.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return app.ImportMessages(natsURL, configPath, contextName, args[0], importOpts)
+	},
+}
+
+var cleanupCmd = &cobra.Command{
+	Use:   "cleanup --filter <name> --action delete|purge",
+	Short: "Delete or purge the streams matching a saved filter",
+	Long: `Delete or purge the streams matching a filter saved in the query builder
+(~/.config/n2s/filters.yaml). The matched streams are listed first. Exits
+non-zero if the action fails for any stream, so it can run from cron.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return app.Cleanup(natsURL, configPath, contextName, cleanupOpts)
+	},
+}
+
 func init() {
-	rootCmd.Flags().StringVarP(&natsURL, "server", "s", "", "NATS server URL (overrides config file)")
-	rootCmd.Flags().StringVarP(&configPath, "config", "c", "", "Config file path")
+	rootCmd.PersistentFlags().StringVarP(&natsURL, "server", "s", "", "NATS server URL (overrides config file)")
+	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", "", "Config file path")
 	rootCmd.Flags().BoolVarP(&readOnly, "read-only", "r", false, "Read-only mode (no deletions)")
 
+	messagesCmd.PersistentFlags().StringVar(&contextName, "context", "", "Context to use (defaults to the current context)")
+
+	messagesImportCmd.Flags().StringVar(&importOpts.Subject, "subject", "", "Publish all messages to this subject")
+	messagesImportCmd.Flags().StringVar(&importOpts.Stream, "stream", "", "Require messages to be stored in this stream")
+	messagesImportCmd.Flags().StringVar(&importOpts.RewriteFrom, "rewrite-from", "", "Subject prefix to rewrite")
+	messagesImportCmd.Flags().StringVar(&importOpts.RewriteTo, "rewrite-to", "", "Replacement for --rewrite-from")
+	messagesImportCmd.Flags().BoolVar(&importOpts.PreserveHeaders, "preserve-headers", true, "Copy original message headers")
+	messagesImportCmd.Flags().BoolVar(&importOpts.SetMsgID, "msg-id", false, "Set Nats-Msg-Id for idempotent imports")
+	messagesImportCmd.Flags().Float64Var(&importOpts.Rate, "rate", 0, "Max messages per second (0 = unlimited)")
+	messagesImportCmd.Flags().BoolVar(&importOpts.ReplayOriginal, "replay-original", false, "Replay with the original inter-message timing")
+
+	messagesCmd.AddCommand(messagesImportCmd)
+
+	cleanupCmd.Flags().StringVar(&contextName, "context", "", "Context to use (defaults to the current context)")
+	cleanupCmd.Flags().StringVar(&cleanupOpts.Filter, "filter", "", "Name of the saved filter")
+	cleanupCmd.Flags().StringVar(&cleanupOpts.Action, "action", "", "Action to run on the matched streams: delete or purge")
+	cleanupCmd.Flags().BoolVar(&cleanupOpts.DryRun, "dry-run", false, "Only list the matched streams")
+	cleanupCmd.Flags().BoolVarP(&cleanupOpts.Yes, "yes", "y", false, "Don't ask for confirmation")
+	cleanupCmd.MarkFlagRequired("filter")
+	cleanupCmd.MarkFlagRequired("action")
+
 	rootCmd.AddCommand(versionCmd)
+	rootCmd.AddCommand(messagesCmd)
+	rootCmd.AddCommand(cleanupCmd)
 }
 
 func main() {
@@ -46,3 +46,24 @@ func Run(serverURL, configPath string, readOnly bool) error {
 	return nil
 }
 
+// connect loads configuration and connects to the selected context.
+// An empty contextName uses the configured default context.
+func connect(serverURL, configPath, contextName string) (*nats.Client, error) {
+	cfg, err := config.Load(configPath, serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	if contextName != "" {
+		if err := cfg.SetContext(contextName); err != nil {
+			return nil, err
+		}
+	}
+
+	nc, err := nats.NewClient(cfg.CurrentContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	return nc, nil
+}
@@ -0,0 +1,155 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/shubhamrasal/n2s/internal/config"
+	"github.com/shubhamrasal/n2s/internal/filter"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// CleanupOptions configures a headless cleanup run
+type CleanupOptions struct {
+	Filter string // Name of a filter saved in the query builder
+	Action string // delete or purge
+	DryRun bool   // Only list the matched streams
+	Yes    bool   // Don't ask for confirmation
+}
+
+// Cleanup deletes or purges the streams matching a saved filter without starting
+// the UI. It fails if the action failed for any stream.
+func Cleanup(serverURL, configPath, contextName string, opts CleanupOptions) error {
+	if opts.Action != "delete" && opts.Action != "purge" {
+		return fmt.Errorf("invalid action %q, expected delete or purge", opts.Action)
+	}
+
+	saved, err := findFilter(opts.Filter)
+	if err != nil {
+		return err
+	}
+	expr, err := filter.FromSaved(saved)
+	if err != nil {
+		return fmt.Errorf("invalid filter %q: %w", opts.Filter, err)
+	}
+
+	nc, err := connect(serverURL, configPath, contextName)
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+
+	streams, err := nc.ListStreams()
+	if err != nil {
+		return fmt.Errorf("failed to list streams: %w", err)
+	}
+
+	// Consumer lag needs the consumers of every stream
+	var consumers map[string][]*models.Consumer
+	if expr.NeedsConsumers() {
+		names := make([]string, len(streams))
+		for i, stream := range streams {
+			names[i] = stream.Name
+		}
+		if consumers, err = nc.ListConsumersOf(names); err != nil {
+			return fmt.Errorf("failed to list consumers: %w", err)
+		}
+	}
+
+	var matched []*models.Stream
+	for _, stream := range streams {
+		if expr.Match(stream, consumers[stream.Name]) {
+			matched = append(matched, stream)
+		}
+	}
+
+	if len(matched) == 0 {
+		fmt.Printf("No streams match filter %q\n", opts.Filter)
+		return nil
+	}
+
+	fmt.Printf("%d streams match filter %q:\n\n", len(matched), opts.Filter)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  NAME\tMSGS\tBYTES\tCONSUMERS")
+	for _, stream := range matched {
+		fmt.Fprintf(w, "  %s\t%d\t%d\t%d\n", stream.Name, stream.State.Messages, stream.State.Bytes, stream.Consumers)
+	}
+	w.Flush()
+	fmt.Println()
+
+	if opts.DryRun {
+		fmt.Println("Dry run, no streams changed")
+		return nil
+	}
+
+	if !opts.Yes && !confirm(fmt.Sprintf("%s %d streams?", capitalize(opts.Action), len(matched))) {
+		return fmt.Errorf("aborted, pass --yes to %s without confirmation", opts.Action)
+	}
+
+	failed := 0
+	for _, stream := range matched {
+		if opts.Action == "delete" {
+			err = nc.DeleteStream(stream.Name)
+		} else {
+			err = nc.PurgeStream(stream.Name)
+		}
+
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "  %s: %v\n", stream.Name, err)
+		} else {
+			fmt.Printf("  %s: %sd\n", stream.Name, opts.Action)
+		}
+	}
+
+	fmt.Printf("Done: %d  Failed: %d\n", len(matched)-failed, failed)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d streams failed to %s", failed, len(matched), opts.Action)
+	}
+
+	return nil
+}
+
+// findFilter looks up a filter saved in the query builder by name
+func findFilter(name string) (models.SavedFilter, error) {
+	filters, err := config.LoadFilters()
+	if err != nil {
+		return models.SavedFilter{}, fmt.Errorf("failed to load saved filters: %w", err)
+	}
+
+	var names []string
+	for _, f := range filters {
+		if f.Name == name {
+			return f, nil
+		}
+		names = append(names, f.Name)
+	}
+
+	if len(names) == 0 {
+		return models.SavedFilter{}, fmt.Errorf("filter %q not found, no filters are saved", name)
+	}
+	return models.SavedFilter{}, fmt.Errorf("filter %q not found, saved filters: %s", name, strings.Join(names, ", "))
+}
+
+// confirm asks a yes/no question on stdin. Without an answer, e.g. when run from
+// cron, it reports false.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		fmt.Println()
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// ImportMessages republishes messages from a JSONL file without starting the UI
+func ImportMessages(serverURL, configPath, contextName, filePath string, opts nats.ImportOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	messages, err := nats.ReadArchivedMessages(file)
+	if err != nil {
+		return err
+	}
+
+	nc, err := connect(serverURL, configPath, contextName)
+	if err != nil {
+		return err
+	}
+	defer nc.Close()
+
+	fmt.Printf("Importing %d messages from %s\n", len(messages), filePath)
+
+	result, err := nc.ImportMessages(context.Background(), messages, opts, func(done, total int, pubErr error) {
+		if pubErr != nil {
+			fmt.Fprintf(os.Stderr, "  [%d/%d] failed: %v\n", done, total, pubErr)
+		} else if done%100 == 0 || done == total {
+			fmt.Printf("  [%d/%d] published\n", done, total)
+		}
+	})
+	if err != nil {
+		return fmt.Errorf("import interrupted: %w", err)
+	}
+
+	fmt.Printf("Published: %d  Failed: %d\n", result.Published, result.Failed)
+	if result.Failed > 0 {
+		return fmt.Errorf("%d messages failed to publish", result.Failed)
+	}
+
+	return nil
+}
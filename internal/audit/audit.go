@@ -0,0 +1,166 @@
+// Package audit appends mutating operations to a JSONL audit log, by default
+// ~/.config/n2s/audit.jsonl, and reads it back.
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// maxLineSize bounds a single entry when reading, large configs included
+const maxLineSize = 4 << 20
+
+// Log is an append-only audit log file. It is safe for concurrent use.
+type Log struct {
+	path string
+	mu   sync.Mutex
+	err  error // Last write error
+}
+
+var (
+	defaultOnce sync.Once
+	defaultLog  *Log
+)
+
+// Default returns the log at ~/.config/n2s/audit.jsonl, shared by all clients
+func Default() *Log {
+	defaultOnce.Do(func() {
+		defaultLog = &Log{}
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			defaultLog.err = fmt.Errorf("failed to get home directory: %w", err)
+			return
+		}
+		defaultLog.path = filepath.Join(homeDir, ".config", "n2s", "audit.jsonl")
+	})
+	return defaultLog
+}
+
+// Path returns the file the log is written to
+func (l *Log) Path() string {
+	return l.path
+}
+
+// Record appends an entry, filling in the time and OS user if unset
+func (l *Log) Record(entry models.AuditEntry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	if entry.User == "" {
+		entry.User = currentUser()
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return l.setErr(fmt.Errorf("failed to encode audit entry: %w", err))
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.path == "" {
+		return l.err
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		l.err = fmt.Errorf("failed to create audit log directory: %w", err)
+		return l.err
+	}
+
+	// A single write of a line opened for appending is not interleaved with other writers
+	file, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		l.err = fmt.Errorf("failed to open audit log: %w", err)
+		return l.err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		l.err = fmt.Errorf("failed to write audit log: %w", err)
+		return l.err
+	}
+	if err := file.Close(); err != nil {
+		l.err = fmt.Errorf("failed to write audit log: %w", err)
+		return l.err
+	}
+
+	l.err = nil
+	return nil
+}
+
+func (l *Log) setErr(err error) error {
+	l.mu.Lock()
+	l.err = err
+	l.mu.Unlock()
+	return err
+}
+
+// Err returns the error of the last failed write, or nil if the last write succeeded
+func (l *Log) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// Entries reads the log, oldest entry first. Lines that can't be decoded are
+// counted in skipped rather than failing the read. A missing log has no entries.
+func (l *Log) Entries() (entries []models.AuditEntry, skipped int, err error) {
+	if l.path == "" {
+		return nil, 0, l.Err()
+	}
+
+	file, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry models.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			skipped++
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return entries, skipped, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	return entries, skipped, nil
+}
+
+var (
+	userOnce sync.Once
+	userName string
+)
+
+// currentUser returns the name of the OS user running n2s
+func currentUser() string {
+	userOnce.Do(func() {
+		if u, err := user.Current(); err == nil {
+			userName = u.Username
+		} else {
+			userName = os.Getenv("USER")
+		}
+		if userName == "" {
+			userName = "unknown"
+		}
+	})
+	return userName
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -29,15 +30,95 @@ type Config struct {
 	currentContext  *Context
 	source          ConfigSource // Where this config was loaded from
 	sourcePath      string       // Specific file path or context name
+
+	// Table columns per view ("streams", "consumers"), in order, optionally with a
+	// width: [name, subjects:40, msgs]
+	Columns map[string][]string `yaml:"columns,omitempty"`
 }
 
 // Context represents a NATS server connection context
 type Context struct {
-	Name          string `yaml:"name"`
-	Server        string `yaml:"server"`
-	Token         string `yaml:"token,omitempty"`
-	Creds         string `yaml:"creds,omitempty"`
-	MetricsPlugin string `yaml:"metrics_plugin,omitempty"`
+	Name          string   `yaml:"name"`
+	Server        string   `yaml:"server"`
+	Servers       []string `yaml:"servers,omitempty"` // Additional seed URLs
+	Token         string   `yaml:"token,omitempty"`
+	Creds         string   `yaml:"creds,omitempty"`
+	User          string   `yaml:"user,omitempty"`
+	Password      string   `yaml:"password,omitempty"`
+	NKey          string   `yaml:"nkey,omitempty"` // Path to an NKey seed file
+	MetricsPlugin string   `yaml:"metrics_plugin,omitempty"`
+
+	// Connection identity and tuning
+	ConnectionName string `yaml:"connection_name,omitempty"` // Shown in CONNZ, defaults to "n2s"
+	InboxPrefix    string `yaml:"inbox_prefix,omitempty"`
+	ConnectTimeout string `yaml:"connect_timeout,omitempty"` // Duration, e.g. 10s
+	ReconnectWait  string `yaml:"reconnect_wait,omitempty"`  // Duration, e.g. 2s
+	MaxReconnects  int    `yaml:"max_reconnects,omitempty"`  // 0 uses the default, -1 reconnects forever
+
+	// JetStream behind a leafnode domain or an imported API prefix (mutually exclusive)
+	JetStreamDomain    string `yaml:"jetstream_domain,omitempty"`
+	JetStreamAPIPrefix string `yaml:"jetstream_api_prefix,omitempty"`
+
+	// TLS client certificate, custom CA bundle and TLS-first handshake
+	TLSCert  string `yaml:"tls_cert,omitempty"`
+	TLSKey   string `yaml:"tls_key,omitempty"`
+	TLSCA    string `yaml:"tls_ca,omitempty"`
+	TLSFirst bool   `yaml:"tls_first,omitempty"`
+
+	// Dead-letter defaults used when copying failed messages from a consumer
+	DLQStream        string `yaml:"dlq_stream,omitempty"`
+	DLQSubjectPrefix string `yaml:"dlq_subject_prefix,omitempty"`
+}
+
+// ServerURLs returns all seed URLs of the context as a comma separated list.
+// Server itself may already hold a comma separated list, as NATS CLI contexts do.
+func (ctx *Context) ServerURLs() string {
+	var urls []string
+	for _, url := range append([]string{ctx.Server}, ctx.Servers...) {
+		for _, u := range strings.Split(url, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				urls = append(urls, u)
+			}
+		}
+	}
+	return strings.Join(urls, ",")
+}
+
+// durationOr parses value as a duration, returning def if it is empty or invalid
+func durationOr(value string, def time.Duration) time.Duration {
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return d
+	}
+	return def
+}
+
+// GetConnectTimeout returns the connection timeout
+func (ctx *Context) GetConnectTimeout() time.Duration {
+	return durationOr(ctx.ConnectTimeout, 10*time.Second)
+}
+
+// GetReconnectWait returns the delay between reconnect attempts
+func (ctx *Context) GetReconnectWait() time.Duration {
+	return durationOr(ctx.ReconnectWait, 2*time.Second)
+}
+
+// GetMaxReconnects returns the reconnect attempt limit (-1 for unlimited)
+func (ctx *Context) GetMaxReconnects() int {
+	if ctx.MaxReconnects == 0 {
+		return 5
+	}
+	if ctx.MaxReconnects < 0 {
+		return -1
+	}
+	return ctx.MaxReconnects
+}
+
+// GetConnectionName returns the client name reported to the server
+func (ctx *Context) GetConnectionName() string {
+	if ctx.ConnectionName != "" {
+		return ctx.ConnectionName
+	}
+	return "n2s"
 }
 
 // natsContext represents the NATS CLI context JSON format
@@ -48,6 +129,55 @@ type natsContext struct {
 	User     string `json:"user"`
 	Password string `json:"password"`
 	NKey     string `json:"nkey"`
+	Cert     string `json:"cert"`
+	Key      string `json:"key"`
+	CA       string `json:"ca"`
+	TLSFirst bool   `json:"tls_first"`
+
+	InboxPrefix        string `json:"inbox_prefix"`
+	JetStreamDomain    string `json:"jetstream_domain"`
+	JetStreamAPIPrefix string `json:"jetstream_api_prefix"`
+}
+
+// expandCredentials expands env vars, tilde and relative paths (against configDir)
+// in credential files, and a token or password that is an env var reference
+func (ctx *Context) expandCredentials(configDir string) error {
+	paths := []struct {
+		name  string
+		value *string
+	}{
+		{"creds", &ctx.Creds},
+		{"nkey", &ctx.NKey},
+		{"tls_cert", &ctx.TLSCert},
+		{"tls_key", &ctx.TLSKey},
+		{"tls_ca", &ctx.TLSCA},
+	}
+	for _, p := range paths {
+		expanded, err := expandPath(*p.value, configDir)
+		if err != nil {
+			return fmt.Errorf("failed to expand %s path: %w", p.name, err)
+		}
+		*p.value = expanded
+	}
+
+	// Secrets may reference an environment variable
+	ctx.Token = expandSecret(ctx.Token)
+	ctx.Password = expandSecret(ctx.Password)
+
+	return nil
+}
+
+// envReference matches a value that is a single $VAR or ${VAR} reference
+var envReference = regexp.MustCompile(`^\$(?:([A-Za-z_][A-Za-z0-9_]*)|\{([A-Za-z_][A-Za-z0-9_]*)\})$`)
+
+// expandSecret replaces a secret that is a single $VAR or ${VAR} reference with the
+// variable's value. Any other value, e.g. a password like "pa$$word", is kept as written.
+func expandSecret(value string) string {
+	m := envReference.FindStringSubmatch(value)
+	if m == nil {
+		return value
+	}
+	return os.Getenv(m[1] + m[2])
 }
 
 // expandPath expands environment variables, tilde, and relative paths
@@ -132,27 +262,30 @@ func readNATSContext(name string) (*Context, error) {
 		return nil, fmt.Errorf("failed to parse NATS context '%s': %w", name, err)
 	}
 
-	// Expand paths in the NATS context
-	creds := natsCtx.Creds
-	if creds != "" {
-		creds, err = expandPath(creds, contextDir)
-		if err != nil {
-			return nil, fmt.Errorf("failed to expand creds path: %w", err)
-		}
+	ctx := &Context{
+		Name:     name,
+		Server:   natsCtx.URL,
+		Token:    natsCtx.Token,
+		Creds:    natsCtx.Creds,
+		User:     natsCtx.User,
+		Password: natsCtx.Password,
+		NKey:     natsCtx.NKey,
+		TLSCert:  natsCtx.Cert,
+		TLSKey:   natsCtx.Key,
+		TLSCA:    natsCtx.CA,
+		TLSFirst: natsCtx.TLSFirst,
+
+		InboxPrefix:        natsCtx.InboxPrefix,
+		JetStreamDomain:    natsCtx.JetStreamDomain,
+		JetStreamAPIPrefix: natsCtx.JetStreamAPIPrefix,
 	}
 
-	// Expand environment variables in token if present
-	token := natsCtx.Token
-	if token != "" && strings.Contains(token, "$") {
-		token = os.ExpandEnv(token)
+	// Expand paths in the NATS context
+	if err := ctx.expandCredentials(contextDir); err != nil {
+		return nil, err
 	}
 
-	return &Context{
-		Name:   name,
-		Server: natsCtx.URL,
-		Token:  token,
-		Creds:  creds,
-	}, nil
+	return ctx, nil
 }
 
 // listNATSContexts returns a list of all available NATS CLI contexts
@@ -308,16 +441,8 @@ func Load(configPath, serverURL string) (*Config, error) {
 		// Expand credential paths with env vars, tilde, and relative paths
 		configDir := filepath.Dir(configPath)
 		for i := range cfg.Contexts {
-			if cfg.Contexts[i].Creds != "" {
-				expanded, err := expandPath(cfg.Contexts[i].Creds, configDir)
-				if err != nil {
-					return nil, fmt.Errorf("failed to expand creds path for context '%s': %w", cfg.Contexts[i].Name, err)
-				}
-				cfg.Contexts[i].Creds = expanded
-			}
-			// Also expand token if it looks like it might be an env var reference
-			if cfg.Contexts[i].Token != "" && strings.Contains(cfg.Contexts[i].Token, "$") {
-				cfg.Contexts[i].Token = os.ExpandEnv(cfg.Contexts[i].Token)
+			if err := cfg.Contexts[i].expandCredentials(configDir); err != nil {
+				return nil, fmt.Errorf("context '%s': %w", cfg.Contexts[i].Name, err)
 			}
 		}
 	}
@@ -436,6 +561,11 @@ func (c *Config) GetRefreshInterval() time.Duration {
 	return d
 }
 
+// TableColumns returns the columns configured for a view's table, or nil for the defaults
+func (c *Config) TableColumns(view string) []string {
+	return c.Columns[view]
+}
+
 // GetConfigSource returns where the configuration was loaded from
 func (c *Config) GetConfigSource() ConfigSource {
 	return c.source
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// FiltersPath returns the path of the saved query builder filters,
+// ~/.config/n2s/filters.yaml
+func FiltersPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "n2s", "filters.yaml"), nil
+}
+
+// LoadFilters loads the saved filters. A missing file holds no filters.
+func LoadFilters() ([]models.SavedFilter, error) {
+	filterPath, err := FiltersPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filterPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []models.SavedFilter{}, nil
+		}
+		return nil, err
+	}
+
+	var config models.FilterConfig
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filterPath, err)
+	}
+
+	return config.Filters, nil
+}
@@ -0,0 +1,166 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// ConsumerCriteria selects consumers across streams. Criteria whose operator is
+// "any" or unset, or whose value is empty, match every consumer.
+type ConsumerCriteria struct {
+	StreamPattern   string // Glob on the stream name
+	NamePattern     string // Glob on the consumer name
+	Type            string // any, durable or ephemeral
+	PendingOp       string
+	Pending         string // Messages yet to be delivered, e.g. 10k
+	AckPendingOp    string
+	AckPending      string
+	RedeliveredOp   string
+	Redelivered     string
+	LastDeliveredOp string
+	LastDelivered   string // Time since the last delivery, e.g. 7d
+	Subject         string // Overlaps the filter subject
+}
+
+// ConsumerMatcher matches consumers against compiled criteria
+type ConsumerMatcher struct {
+	stream, name  *regexp.Regexp
+	typ           string
+	counts        []countCriterion
+	lastOp        string
+	lastDelivered time.Duration
+	subject       string
+}
+
+type countCriterion struct {
+	op    string
+	value float64
+	count func(c *models.Consumer) uint64
+}
+
+// Compile validates the criteria, reporting invalid values by criterion
+func (c ConsumerCriteria) Compile() (*ConsumerMatcher, error) {
+	m := &ConsumerMatcher{subject: strings.TrimSpace(c.Subject)}
+
+	if pattern := strings.TrimSpace(c.StreamPattern); pattern != "" && pattern != "*" {
+		m.stream = globPattern(pattern)
+	}
+	if pattern := strings.TrimSpace(c.NamePattern); pattern != "" && pattern != "*" {
+		m.name = globPattern(pattern)
+	}
+
+	switch c.Type {
+	case "", "any":
+	case "durable", "ephemeral":
+		m.typ = c.Type
+	default:
+		return nil, fmt.Errorf("Type: expected durable or ephemeral, got %q", c.Type)
+	}
+
+	counts := []struct {
+		label, op, value string
+		count            func(c *models.Consumer) uint64
+	}{
+		{"Pending", c.PendingOp, c.Pending, func(c *models.Consumer) uint64 { return c.NumPending }},
+		{"Ack pending", c.AckPendingOp, c.AckPending, func(c *models.Consumer) uint64 { return c.NumAckPending }},
+		{"Redelivered", c.RedeliveredOp, c.Redelivered, func(c *models.Consumer) uint64 { return c.NumRedelivered }},
+	}
+	for _, criterion := range counts {
+		value := strings.TrimSpace(criterion.value)
+		if criterion.op == "" || criterion.op == "any" || value == "" {
+			continue
+		}
+		if !validOp(criterion.op) {
+			return nil, fmt.Errorf("%s: invalid operator %q", criterion.label, criterion.op)
+		}
+		n, err := ParseCount(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid count %q", criterion.label, value)
+		}
+		m.counts = append(m.counts, countCriterion{op: criterion.op, value: n, count: criterion.count})
+	}
+
+	if value := strings.TrimSpace(c.LastDelivered); c.LastDeliveredOp != "" && c.LastDeliveredOp != "any" && value != "" {
+		if !validOp(c.LastDeliveredOp) {
+			return nil, fmt.Errorf("Last delivered: invalid operator %q", c.LastDeliveredOp)
+		}
+		d, err := ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("Last delivered: invalid duration %q", value)
+		}
+		m.lastOp, m.lastDelivered = c.LastDeliveredOp, d
+	}
+
+	return m, nil
+}
+
+func validOp(op string) bool {
+	switch op {
+	case "=", "!=", ">", ">=", "<", "<=":
+		return true
+	}
+	return false
+}
+
+// MatchStream reports whether consumers of the stream can match, so only their
+// consumers need to be listed
+func (m *ConsumerMatcher) MatchStream(name string) bool {
+	return m.stream == nil || m.stream.MatchString(name)
+}
+
+// Match reports whether a consumer matches all criteria. A consumer that never
+// delivered a message counts as having last delivered longer ago than any duration.
+func (m *ConsumerMatcher) Match(consumer *models.Consumer) bool {
+	if !m.MatchStream(consumer.Stream) {
+		return false
+	}
+	if m.name != nil && !m.name.MatchString(consumer.Name) {
+		return false
+	}
+
+	durable := consumer.Config.Durable != ""
+	if m.typ == "durable" && !durable || m.typ == "ephemeral" && durable {
+		return false
+	}
+
+	for _, criterion := range m.counts {
+		if !compare(criterion.op, float64(criterion.count(consumer)), criterion.value) {
+			return false
+		}
+	}
+
+	if m.lastOp != "" {
+		if consumer.Delivered.Last.IsZero() {
+			if m.lastOp != ">" && m.lastOp != ">=" && m.lastOp != "!=" {
+				return false
+			}
+		} else {
+			since := time.Since(consumer.Delivered.Last)
+			if m.lastOp == "=" {
+				// Within 10% tolerance, as for stream durations
+				if since < time.Duration(float64(m.lastDelivered)*0.9) || since > time.Duration(float64(m.lastDelivered)*1.1) {
+					return false
+				}
+			} else if !compare(m.lastOp, float64(since), float64(m.lastDelivered)) {
+				return false
+			}
+		}
+	}
+
+	// Consumers without a filter subject receive all of the stream's subjects
+	if m.subject != "" {
+		subject := consumer.Config.FilterSubject
+		if subject == "" {
+			subject = ">"
+		}
+		if !SubjectsOverlap(subject, m.subject) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,255 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// target is what an expression is matched against
+type target struct {
+	stream    *models.Stream
+	consumers []*models.Consumer // nil if not fetched
+}
+
+type node interface {
+	match(t *target) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n *andNode) match(t *target) bool {
+	return n.left.match(t) && n.right.match(t)
+}
+
+type orNode struct{ left, right node }
+
+func (n *orNode) match(t *target) bool {
+	return n.left.match(t) || n.right.match(t)
+}
+
+type notNode struct{ operand node }
+
+func (n *notNode) match(t *target) bool {
+	return !n.operand.match(t)
+}
+
+// nameNode is a bare word, matching names containing it
+type nameNode struct{ text string }
+
+func (n *nameNode) match(t *target) bool {
+	return strings.Contains(strings.ToLower(t.stream.Name), n.text)
+}
+
+// termNode compares a field with a value
+type termNode struct {
+	field   string
+	key     string // Metadata key
+	kind    fieldKind
+	op      string
+	value   string
+	number  float64        // Parsed value of count, size and duration fields
+	pattern *regexp.Regexp // Glob for '=' and '!=', regular expression for '~' and '!~'
+}
+
+func (n *termNode) match(t *target) bool {
+	stream := t.stream
+	switch n.kind {
+	case kindCount, kindBytes:
+		return compare(n.op, n.count(t), n.number)
+	case kindDuration:
+		d, ok := n.duration(stream)
+		if !ok {
+			return false
+		}
+		if n.op == "=" {
+			// Within 10% tolerance
+			return d >= time.Duration(n.number*0.9) && d <= time.Duration(n.number*1.1)
+		}
+		return compare(n.op, float64(d), n.number)
+	case kindSubject:
+		return n.matchSubjects(stream.Config.Subjects)
+	}
+
+	var actual string
+	switch n.field {
+	case "name":
+		actual = stream.Name
+	case "storage":
+		actual = stream.Config.Storage
+	case "retention":
+		actual = stream.Config.Retention
+	case "metadata":
+		actual = stream.Config.Metadata[n.key]
+	}
+	matched := n.pattern.MatchString(actual)
+	if n.op == "!=" || n.op == "!~" {
+		return !matched
+	}
+	return matched
+}
+
+func (n *termNode) count(t *target) float64 {
+	stream := t.stream
+	switch n.field {
+	case "msgs":
+		return float64(stream.State.Messages)
+	case "bytes":
+		return float64(stream.State.Bytes)
+	case "consumers":
+		return float64(stream.Consumers)
+	case "replicas":
+		return float64(stream.Config.Replicas)
+	case "deleted":
+		return float64(stream.State.NumDeleted)
+	case "lag":
+		// The highest number of messages any consumer has yet to be delivered
+		var lag uint64
+		for _, consumer := range t.consumers {
+			lag = max(lag, consumer.NumPending)
+		}
+		return float64(lag)
+	}
+	return 0
+}
+
+// duration returns the stream's age, the age of its oldest message, or how long ago it
+// last received a message. A stream without messages counts from when it was created.
+func (n *termNode) duration(stream *models.Stream) (time.Duration, bool) {
+	since := stream.Created
+	switch {
+	case n.field == "first":
+		since = FirstMessageTime(stream)
+	case n.field == "idle" && !stream.State.LastTime.IsZero():
+		since = stream.State.LastTime
+	}
+	if since.IsZero() {
+		return 0, false
+	}
+	return time.Since(since), true
+}
+
+// FirstMessageTime returns when the oldest message in the stream was stored, or when
+// the stream was created if it holds none
+func FirstMessageTime(stream *models.Stream) time.Time {
+	if stream.State.FirstTime.IsZero() {
+		return stream.Created
+	}
+	return stream.State.FirstTime
+}
+
+// matchSubjects compares the stream's subjects. '=' matches when any of them overlaps
+// the value, as the server's stream list filter does, and '~' when any matches the
+// regular expression.
+func (n *termNode) matchSubjects(subjects []string) bool {
+	matched := false
+	for _, subject := range subjects {
+		if n.pattern != nil && n.pattern.MatchString(subject) || n.pattern == nil && SubjectsOverlap(subject, n.value) {
+			matched = true
+			break
+		}
+	}
+	if n.op == "!=" || n.op == "!~" {
+		return !matched
+	}
+	return matched
+}
+
+func compare(op string, actual, target float64) bool {
+	switch op {
+	case "=":
+		return actual == target
+	case "!=":
+		return actual != target
+	case ">":
+		return actual > target
+	case ">=":
+		return actual >= target
+	case "<":
+		return actual < target
+	case "<=":
+		return actual <= target
+	}
+	return false
+}
+
+// SubjectsOverlap reports whether some subject matches both a and b, which may hold
+// the '*' and '>' wildcards
+func SubjectsOverlap(a, b string) bool {
+	at, bt := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(at) && i < len(bt); i++ {
+		if at[i] == ">" || bt[i] == ">" {
+			return true
+		}
+		if at[i] != "*" && bt[i] != "*" && at[i] != bt[i] {
+			return false
+		}
+	}
+	return len(at) == len(bt)
+}
+
+// Match reports whether a stream matches the expression. consumers are the stream's
+// consumers, needed only if NeedsConsumers reports true.
+func (e *Expr) Match(stream *models.Stream, consumers []*models.Consumer) bool {
+	if e == nil || e.root == nil {
+		return true
+	}
+	return e.root.match(&target{stream: stream, consumers: consumers})
+}
+
+// NeedsInfo reports whether matching needs more of a stream than its name
+func (e *Expr) NeedsInfo() bool {
+	if e == nil {
+		return false
+	}
+	return anyTerm(e.root, func(t *termNode) bool { return t.field != "name" })
+}
+
+// NeedsConsumers reports whether matching needs the stream's consumers
+func (e *Expr) NeedsConsumers() bool {
+	if e == nil {
+		return false
+	}
+	return anyTerm(e.root, func(t *termNode) bool { return t.field == "lag" })
+}
+
+// anyTerm reports whether f is true for any term of n
+func anyTerm(n node, f func(t *termNode) bool) bool {
+	switch n := n.(type) {
+	case *andNode:
+		return anyTerm(n.left, f) || anyTerm(n.right, f)
+	case *orNode:
+		return anyTerm(n.left, f) || anyTerm(n.right, f)
+	case *notNode:
+		return anyTerm(n.operand, f)
+	case *termNode:
+		return f(n)
+	}
+	return false
+}
+
+// ListingSubject returns the subject of a "subject=<subject>" term every match must
+// satisfy, or "". Stream listings can be filtered by it on the server.
+func (e *Expr) ListingSubject() string {
+	if e == nil {
+		return ""
+	}
+	return listingSubject(e.root)
+}
+
+func listingSubject(n node) string {
+	switch n := n.(type) {
+	case *andNode:
+		if subject := listingSubject(n.left); subject != "" {
+			return subject
+		}
+		return listingSubject(n.right)
+	case *termNode:
+		if n.kind == kindSubject && n.op == "=" {
+			return n.value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,445 @@
+// Package filter implements the expression language used to filter streams, e.g.
+// "msgs>1e6 AND storage=memory" or "NOT (subject~^orders OR metadata.team=payments)".
+//
+// A term compares a field with a value. Terms are combined with AND (or juxtaposition),
+// OR and NOT, and grouped with parentheses. A bare word matches stream names containing it.
+package filter
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SyntaxError is returned by Parse for malformed expressions
+type SyntaxError struct {
+	Pos int // Byte offset in the expression
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s (column %d)", e.Msg, e.Pos+1)
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString // Quoted word
+	tokOp
+	tokValue // Value following an operator
+	tokAnd
+	tokOr
+	tokNot
+	tokLParen
+	tokRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits an expression into tokens. The value after an operator is read up to the
+// next space or ')', so it can hold characters like '>' or '*' as in subject=orders.>
+func lex(input string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(input) {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokRParen, ")", i})
+			i++
+		case strings.HasPrefix(input[i:], "&&"):
+			tokens = append(tokens, token{tokAnd, "&&", i})
+			i += 2
+		case strings.HasPrefix(input[i:], "||"):
+			tokens = append(tokens, token{tokOr, "||", i})
+			i += 2
+		case c == '!' && !strings.HasPrefix(input[i:], "!=") && !strings.HasPrefix(input[i:], "!~"):
+			tokens = append(tokens, token{tokNot, "!", i})
+			i++
+		case c == '"' || c == '\'':
+			text, end, err := lexQuoted(input, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{tokString, text, i})
+			i = end
+		case isOpChar(c):
+			start := i
+			op := string(c)
+			switch two := input[i:min(i+2, len(input))]; two {
+			case ">=", "<=", "!=", "!~", "==":
+				op = two
+			}
+			i += len(op)
+			if op == "==" {
+				op = "="
+			}
+			tokens = append(tokens, token{tokOp, op, start})
+
+			value, end, err := lexValue(input, i, op)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, value)
+			i = end
+		default:
+			start := i
+			for i < len(input) && isWordChar(input, i) {
+				i++
+			}
+			tokens = append(tokens, token{keyword(input[start:i]), input[start:i], start})
+		}
+	}
+	return append(tokens, token{tokEOF, "", len(input)}), nil
+}
+
+func lexQuoted(input string, start int) (string, int, error) {
+	quote := input[start]
+	end := strings.IndexByte(input[start+1:], quote)
+	if end < 0 {
+		return "", 0, &SyntaxError{Pos: start, Msg: "unterminated quote"}
+	}
+	return input[start+1 : start+1+end], start + end + 2, nil
+}
+
+func lexValue(input string, i int, op string) (token, int, error) {
+	for i < len(input) && (input[i] == ' ' || input[i] == '\t') {
+		i++
+	}
+	if i < len(input) && (input[i] == '"' || input[i] == '\'') {
+		text, end, err := lexQuoted(input, i)
+		if err != nil {
+			return token{}, 0, err
+		}
+		return token{tokValue, text, i}, end, nil
+	}
+
+	start := i
+	for i < len(input) && input[i] != ' ' && input[i] != '\t' && input[i] != ')' {
+		i++
+	}
+	if i == start {
+		return token{}, 0, &SyntaxError{Pos: start, Msg: fmt.Sprintf("expected a value after %q", op)}
+	}
+	return token{tokValue, input[start:i], start}, i, nil
+}
+
+func isOpChar(c byte) bool {
+	return c == '=' || c == '!' || c == '<' || c == '>' || c == '~'
+}
+
+func isWordChar(input string, i int) bool {
+	c := input[i]
+	if c == ' ' || c == '\t' || c == '(' || c == ')' || c == '"' || c == '\'' || isOpChar(c) {
+		return false
+	}
+	return !strings.HasPrefix(input[i:], "&&") && !strings.HasPrefix(input[i:], "||")
+}
+
+func keyword(word string) tokenKind {
+	switch strings.ToUpper(word) {
+	case "AND":
+		return tokAnd
+	case "OR":
+		return tokOr
+	case "NOT":
+		return tokNot
+	}
+	return tokWord
+}
+
+// Expr is a parsed filter expression. The zero value and nil match every stream.
+type Expr struct {
+	root node
+	text string
+}
+
+// Parse parses a filter expression. An empty expression matches every stream.
+func Parse(text string) (*Expr, error) {
+	tokens, err := lex(text)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokEOF {
+		return &Expr{text: text}, nil
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+	return &Expr{root: root, text: text}, nil
+}
+
+// String returns the expression as it was parsed
+func (e *Expr) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.text
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left, right}
+	}
+	return left, nil
+}
+
+// parseAnd parses terms joined by AND, or just written one after another
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek().kind {
+		case tokAnd:
+			p.next()
+		case tokWord, tokString, tokNot, tokLParen:
+		default:
+			return left, nil
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left, right}
+	}
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peek().kind == tokNot {
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return nil, &SyntaxError{Pos: closing.pos, Msg: "missing ')'"}
+		}
+		return inner, nil
+	case tokWord, tokString:
+		if p.peek().kind != tokOp {
+			return &nameNode{strings.ToLower(tok.text)}, nil
+		}
+		op := p.next()
+		value := p.next()
+		return newTerm(tok, op, value)
+	case tokEOF:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: "unexpected end of expression"}
+	case tokOp:
+		return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("expected a field before %q", tok.text)}
+	}
+	return nil, &SyntaxError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+}
+
+type fieldKind int
+
+const (
+	kindCount fieldKind = iota
+	kindBytes
+	kindDuration
+	kindString
+	kindSubject
+	kindMetadata
+)
+
+// fields maps field names, and their aliases, to their kind
+var fields = map[string]fieldKind{
+	"name":      kindString,
+	"stream":    kindString,
+	"storage":   kindString,
+	"retention": kindString,
+	"subject":   kindSubject,
+	"subjects":  kindSubject,
+	"msgs":      kindCount,
+	"messages":  kindCount,
+	"consumers": kindCount,
+	"replicas":  kindCount,
+	"deleted":   kindCount,
+	"lag":       kindCount,
+	"bytes":     kindBytes,
+	"size":      kindBytes,
+	"age":       kindDuration,
+	"first":     kindDuration,
+	"idle":      kindDuration,
+}
+
+var aliases = map[string]string{
+	"stream":   "name",
+	"subjects": "subject",
+	"messages": "msgs",
+	"size":     "bytes",
+}
+
+// Fields returns the names of the fields terms can compare, for help texts
+func Fields() []string {
+	return []string{"name", "subject", "msgs", "bytes", "consumers", "storage", "retention",
+		"replicas", "deleted", "lag", "age", "first", "idle", "metadata.<key>"}
+}
+
+func newTerm(fieldTok, opTok, valueTok token) (node, error) {
+	field := strings.ToLower(fieldTok.text)
+	t := &termNode{op: opTok.text, value: valueTok.text}
+
+	if key, ok := strings.CutPrefix(fieldTok.text, "metadata."); ok && key != "" {
+		t.field, t.key, t.kind = "metadata", key, kindMetadata
+	} else if kind, ok := fields[field]; ok {
+		t.field, t.kind = field, kind
+		if alias, ok := aliases[field]; ok {
+			t.field = alias
+		}
+	} else {
+		return nil, &SyntaxError{Pos: fieldTok.pos, Msg: fmt.Sprintf("unknown field %q", fieldTok.text)}
+	}
+
+	switch t.kind {
+	case kindCount, kindBytes, kindDuration:
+		if t.op == "~" || t.op == "!~" {
+			return nil, &SyntaxError{Pos: opTok.pos, Msg: fmt.Sprintf("%q can't be used with %s", t.op, t.field)}
+		}
+	default:
+		if t.op != "=" && t.op != "!=" && t.op != "~" && t.op != "!~" {
+			return nil, &SyntaxError{Pos: opTok.pos, Msg: fmt.Sprintf("%q can't be used with %s", t.op, t.field)}
+		}
+	}
+
+	var err error
+	switch t.kind {
+	case kindCount:
+		t.number, err = ParseCount(t.value)
+	case kindBytes:
+		t.number, err = ParseSize(t.value)
+	case kindDuration:
+		var d time.Duration
+		d, err = ParseDuration(t.value)
+		t.number = float64(d)
+	default:
+		if t.op == "~" || t.op == "!~" {
+			t.pattern, err = regexp.Compile("(?i)" + t.value)
+		} else if t.kind != kindSubject {
+			t.pattern = globPattern(t.value)
+		}
+	}
+	if err != nil {
+		return nil, &SyntaxError{Pos: valueTok.pos, Msg: fmt.Sprintf("invalid value %q for %s", t.value, t.field)}
+	}
+	return t, nil
+}
+
+// globPattern compiles a case-insensitive pattern where '*' matches anything
+func globPattern(glob string) *regexp.Regexp {
+	parts := strings.Split(glob, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("(?i)^" + strings.Join(parts, ".*") + "$")
+}
+
+// ParseCount parses a count such as "1000", "1e6" or "10k"
+func ParseCount(text string) (float64, error) {
+	return parseScaled(text, map[string]float64{"k": 1e3, "m": 1e6, "g": 1e9, "t": 1e12})
+}
+
+// ParseSize parses a size in bytes such as "512", "10MB" or "2GB", in powers of 1024
+func ParseSize(text string) (float64, error) {
+	return parseScaled(strings.TrimSuffix(strings.ToLower(text), "b"), map[string]float64{
+		"k": 1 << 10, "m": 1 << 20, "g": 1 << 30, "t": 1 << 40,
+	})
+}
+
+func parseScaled(text string, units map[string]float64) (float64, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	scale := 1.0
+	if len(text) > 1 {
+		if unit, ok := units[text[len(text)-1:]]; ok {
+			scale = unit
+			text = text[:len(text)-1]
+		}
+	}
+
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil || n < 0 || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fmt.Errorf("invalid number %q", text)
+	}
+	return n * scale, nil
+}
+
+// ParseDuration parses a duration such as "90s", "12h", "7d" or "2w"
+func ParseDuration(text string) (time.Duration, error) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	for suffix, unit := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if n, ok := strings.CutSuffix(text, suffix); ok {
+			days, err := strconv.ParseFloat(n, 64)
+			if err != nil || days < 0 {
+				return 0, fmt.Errorf("invalid duration %q", text)
+			}
+			return time.Duration(days * float64(unit)), nil
+		}
+	}
+
+	d, err := time.ParseDuration(text)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", text)
+	}
+	return d, nil
+}
@@ -0,0 +1,83 @@
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// FromSaved builds the expression a saved filter stands for: all of its criteria
+// and its own expression must match. Criteria whose operator is "any" or unset, or
+// whose value is empty, are left out. Invalid values are reported by criterion.
+func FromSaved(f models.SavedFilter) (*Expr, error) {
+	ageUnit := f.AgeUnit
+	if ageUnit == "" {
+		ageUnit = "h"
+	}
+
+	criteria := []struct {
+		label, field, op, value string
+	}{
+		{"Name pattern", "name", "=", f.NamePattern},
+		{"Age", "first", f.AgeOp, strconv.Itoa(f.AgeValue) + ageUnit}, // Age of the oldest message
+		{"Consumers", "consumers", f.ConsumerOp, strconv.Itoa(f.ConsumerValue)},
+		{"Messages", "msgs", f.MessagesOp, strconv.FormatInt(f.MessagesValue, 10)},
+		{"Bytes", "bytes", f.BytesOp, f.BytesValue},
+		{"Idle", "idle", f.IdleOp, f.IdleValue},
+		{"Storage", "storage", "=", f.Storage},
+		{"Replicas", "replicas", f.ReplicasOp, strconv.Itoa(f.ReplicasValue)},
+		{"Subject", "subject", "=", f.Subject},
+		{"Lag", "lag", f.LagOp, strconv.FormatInt(f.LagValue, 10)},
+	}
+
+	if f.Metadata != "" {
+		key, value, ok := strings.Cut(f.Metadata, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, fmt.Errorf("Metadata: expected key=value, got %q", f.Metadata)
+		}
+		criteria = append(criteria, struct{ label, field, op, value string }{
+			"Metadata", "metadata." + strings.TrimSpace(key), "=", strings.TrimSpace(value),
+		})
+	}
+
+	var terms []string
+	for _, c := range criteria {
+		value := strings.TrimSpace(c.value)
+		if c.op == "" || c.op == "any" || value == "" || value == "*" && c.field == "name" || value == "any" && c.field == "storage" {
+			continue
+		}
+
+		term := c.field + c.op + quote(value)
+		if _, err := Parse(term); err != nil {
+			var syntaxErr *SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return nil, fmt.Errorf("%s: %s", c.label, syntaxErr.Msg)
+			}
+			return nil, fmt.Errorf("%s: %w", c.label, err)
+		}
+		terms = append(terms, term)
+	}
+
+	if expression := strings.TrimSpace(f.Expression); expression != "" {
+		if _, err := Parse(expression); err != nil {
+			return nil, fmt.Errorf("Expression: %w", err)
+		}
+		terms = append(terms, "("+expression+")")
+	}
+
+	return Parse(strings.Join(terms, " AND "))
+}
+
+// quote quotes values holding characters that would end them early
+func quote(value string) string {
+	if !strings.ContainsAny(value, " \t()\"'") {
+		return value
+	}
+	if strings.Contains(value, `"`) {
+		return "'" + value + "'"
+	}
+	return `"` + value + `"`
+}
@@ -0,0 +1,33 @@
+package models
+
+// AccountInfo holds JetStream usage and limits for the connected account
+type AccountInfo struct {
+	Domain    string
+	Usage     AccountTier
+	APITotal  uint64
+	APIErrors uint64
+	Tiers     map[string]AccountTier // Per replication tier (R1, R3, ...) when tiered limits are used
+}
+
+// AccountTier holds usage and limits for the account or one of its tiers
+type AccountTier struct {
+	Memory         uint64
+	Store          uint64
+	ReservedMemory uint64
+	ReservedStore  uint64
+	Streams        int
+	Consumers      int
+	Limits         AccountLimits
+}
+
+// AccountLimits holds JetStream account limits (-1 or 0 = unlimited)
+type AccountLimits struct {
+	MaxMemory            int64
+	MaxStore             int64
+	MaxStreams           int
+	MaxConsumers         int
+	MaxAckPending        int
+	MemoryMaxStreamBytes int64
+	StoreMaxStreamBytes  int64
+	MaxBytesRequired     bool
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Audited operations
+const (
+	AuditStreamDelete           = "stream.delete"
+	AuditStreamPurge            = "stream.purge"
+	AuditStreamUpdate           = "stream.update"
+	AuditStreamLeaderStepDown   = "stream.leader_stepdown"
+	AuditConsumerDelete         = "consumer.delete"
+	AuditConsumerUpdate         = "consumer.update"
+	AuditConsumerPause          = "consumer.pause"
+	AuditConsumerResume         = "consumer.resume"
+	AuditConsumerLeaderStepDown = "consumer.leader_stepdown"
+	AuditMessageDelete          = "message.delete"
+	AuditMessagesImport         = "messages.import"
+	AuditMessagesRedrive        = "messages.redrive"
+)
+
+// Audit results
+const (
+	AuditResultOK     = "ok"
+	AuditResultFailed = "failed"
+)
+
+// AuditEntry records one mutating operation, one line of the audit log
+type AuditEntry struct {
+	Time      time.Time       `json:"time"`
+	User      string          `json:"user"` // OS user running n2s
+	Context   string          `json:"context"`
+	Server    string          `json:"server"`
+	Operation string          `json:"operation"` // One of the Audit* operations
+	Target    string          `json:"target"`    // Stream, or stream/consumer
+	Details   string          `json:"details,omitempty"`
+	Before    json.RawMessage `json:"before,omitempty"` // Configuration before the operation, as sent to the server
+	After     json.RawMessage `json:"after,omitempty"`  // Configuration after the operation
+	Result    string          `json:"result"`
+	Error     string          `json:"error,omitempty"`
+}
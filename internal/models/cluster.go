@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// ClusterInfo describes the RAFT group of a clustered stream or consumer
+type ClusterInfo struct {
+	Name        string // Cluster name
+	Leader      string
+	LeaderSince time.Time
+	Replicas    []PeerInfo // Followers; the leader is not included
+}
+
+// PeerInfo describes a follower replica
+type PeerInfo struct {
+	Name    string
+	Current bool          // Caught up with the leader
+	Offline bool          // Not reachable
+	Active  time.Duration // Time since the replica was last seen
+	Lag     uint64        // Operations behind the leader
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// Connection represents a client connection reported by CONNZ
+type Connection struct {
+	CID           uint64
+	Server        string // Name of the server the client is connected to
+	Name          string
+	IP            string
+	Port          int
+	Account       string
+	User          string
+	Lang          string
+	Version       string
+	Start         time.Time
+	LastActivity  time.Time
+	RTT           time.Duration
+	Uptime        string
+	PendingBytes  int
+	InMsgs        int64
+	OutMsgs       int64
+	InBytes       int64
+	OutBytes      int64
+	NumSubs       uint32
+	Subscriptions []string
+}
+
+// Connection event types recorded by the client
+const (
+	ConnEventConnected    = "connected"
+	ConnEventDisconnected = "disconnected"
+	ConnEventReconnected  = "reconnected"
+	ConnEventClosed       = "closed"
+	ConnEventLameDuck     = "lame duck"
+	ConnEventDiscovered   = "discovered servers"
+	ConnEventSlowConsumer = "slow consumer"
+	ConnEventAsyncError   = "async error"
+)
+
+// ConnEvent is a change in the state of our own NATS connection
+type ConnEvent struct {
+	Time   time.Time
+	Type   string
+	Server string // Server URL (and name) at the time of the event
+	Detail string
+}
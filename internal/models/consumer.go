@@ -14,6 +14,7 @@ type Consumer struct {
 	NumRedelivered uint64
 	NumWaiting   int
 	LastActivity time.Time
+	Cluster      *ClusterInfo // nil when the server is not clustered
 }
 
 // ConsumerConfig holds consumer configuration
@@ -21,6 +22,7 @@ type ConsumerConfig struct {
 	Name           string
 	Durable        string
 	FilterSubject  string
+	DeliverSubject string // Set for push consumers
 	DeliverPolicy  string // all, last, new, by_start_sequence, by_start_time
 	AckPolicy      string // none, all, explicit
 	AckWait        time.Duration
@@ -32,6 +34,27 @@ type ConsumerConfig struct {
 	Heartbeat      time.Duration
 }
 
+// ConsumerPatch is a partial consumer configuration. Nil fields are left as they are.
+type ConsumerPatch struct {
+	MaxDeliver    *int // -1 for unlimited
+	MaxAckPending *int // -1 for unlimited
+	AckWait       *time.Duration
+}
+
+// Apply returns cfg with the patch applied
+func (p ConsumerPatch) Apply(cfg ConsumerConfig) ConsumerConfig {
+	if p.MaxDeliver != nil {
+		cfg.MaxDeliver = *p.MaxDeliver
+	}
+	if p.MaxAckPending != nil {
+		cfg.MaxAckPending = *p.MaxAckPending
+	}
+	if p.AckWait != nil {
+		cfg.AckWait = *p.AckWait
+	}
+	return cfg
+}
+
 // ConsumerSeqInfo holds sequence information
 type ConsumerSeqInfo struct {
 	Stream   uint64
@@ -47,3 +70,12 @@ type PendingMessage struct {
 	Timestamp   time.Time
 }
 
+// DeadLetter is a message that exhausted MaxDeliver or was terminated,
+// as reported by JetStream advisories
+type DeadLetter struct {
+	StreamSeq  uint64
+	Deliveries uint64
+	Reason     string // Advisory type: max deliveries or terminated
+	Time       time.Time
+	Message    *Message // nil if the message no longer exists in the stream
+}
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Advisory event types, derived from the $JS.EVENT.ADVISORY subject
+const (
+	EventMaxDeliveries         = "MAX_DELIVERIES"
+	EventMsgTerminated         = "MSG_TERMINATED"
+	EventMsgNaked              = "MSG_NAKED"
+	EventStreamCreated         = "STREAM_CREATED"
+	EventStreamDeleted         = "STREAM_DELETED"
+	EventStreamUpdated         = "STREAM_UPDATED"
+	EventConsumerCreated       = "CONSUMER_CREATED"
+	EventConsumerDeleted       = "CONSUMER_DELETED"
+	EventConsumerPaused        = "CONSUMER_PAUSE"
+	EventStreamLeaderElected   = "STREAM_LEADER_ELECTED"
+	EventStreamQuorumLost      = "STREAM_QUORUM_LOST"
+	EventConsumerLeaderElected = "CONSUMER_LEADER_ELECTED"
+	EventConsumerQuorumLost    = "CONSUMER_QUORUM_LOST"
+	EventAPIAudit              = "API"
+)
+
+// Event represents a decoded JetStream advisory
+type Event struct {
+	ID          string
+	Type        string // One of the Event* constants, or the raw subject suffix for unknown advisories
+	Subject     string
+	Time        time.Time
+	Stream      string
+	Consumer    string
+	StreamSeq   uint64
+	Deliveries  uint64
+	Description string
+	Raw         []byte
+}
@@ -11,6 +11,23 @@ type SavedFilter struct {
 	ConsumerValue   int    `yaml:"consumer_value"`
 	MessagesOp      string `yaml:"messages_op"`
 	MessagesValue   int64  `yaml:"messages_value"`
+
+	// Criteria added later are omitted from the file when unset, so older files load
+	// unchanged. Sizes and durations are kept as typed, e.g. "2GB" and "7d".
+	BytesOp       string `yaml:"bytes_op,omitempty"`
+	BytesValue    string `yaml:"bytes_value,omitempty"`
+	IdleOp        string `yaml:"idle_op,omitempty"` // Time since the last message
+	IdleValue     string `yaml:"idle_value,omitempty"`
+	Storage       string `yaml:"storage,omitempty"` // file or memory
+	ReplicasOp    string `yaml:"replicas_op,omitempty"`
+	ReplicasValue int    `yaml:"replicas_value,omitempty"`
+	Subject       string `yaml:"subject,omitempty"`  // Matches streams with an overlapping subject
+	Metadata      string `yaml:"metadata,omitempty"` // key=value, the value may use '*' globs
+	LagOp         string `yaml:"lag_op,omitempty"`   // Highest consumer pending count
+	LagValue      int64  `yaml:"lag_value,omitempty"`
+
+	// Filter expression as in the stream list, combined with the criteria above
+	Expression string `yaml:"expression,omitempty"`
 }
 
 // FilterConfig holds all saved filters
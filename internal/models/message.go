@@ -22,3 +22,13 @@ type MessageDetail struct {
 	Size      int
 }
 
+// ArchivedMessage is the JSONL representation of a stream message used when
+// importing or replaying messages from a file
+type ArchivedMessage struct {
+	Stream    string              `json:"stream,omitempty"`
+	Sequence  uint64              `json:"seq,omitempty"`
+	Subject   string              `json:"subject"`
+	Headers   map[string][]string `json:"headers,omitempty"`
+	Data      []byte              `json:"data"`
+	Timestamp time.Time           `json:"time"`
+}
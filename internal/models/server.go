@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// Server monitoring request kinds sent to $SYS.REQ.SERVER.PING.<kind>
+const (
+	ServerVarz    = "VARZ"
+	ServerJsz     = "JSZ"
+	ServerHealthz = "HEALTHZ"
+)
+
+// ServerStatus combines the VARZ, JSZ and HEALTHZ responses of a single server
+type ServerStatus struct {
+	ID            string
+	Name          string
+	Host          string
+	Version       string
+	Cluster       string
+	Start         time.Time
+	Uptime        string
+	CPU           float64
+	Mem           int64
+	Cores         int
+	Connections   int
+	Routes        int
+	Leafnodes     int
+	SlowConsumers int64
+
+	// JetStream usage (JSZ)
+	JetStream   bool
+	JSMemory    uint64
+	JSStore     uint64
+	JSMaxMemory int64
+	JSMaxStore  int64
+	Streams     int
+	Consumers   int
+	MetaLeader  string
+
+	// Health (HEALTHZ)
+	Healthy      bool
+	HealthStatus string
+	HealthError  string
+
+	Raw map[string][]byte // Raw JSON response per request kind
+}
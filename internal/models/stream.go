@@ -9,8 +9,10 @@ type Stream struct {
 	Messages  uint64
 	Bytes     uint64
 	Consumers int
+	Created   time.Time
 	Config    StreamConfig
 	State     StreamState
+	Cluster   *ClusterInfo // nil when the server is not clustered
 }
 
 // StreamConfig holds stream configuration
@@ -25,6 +27,9 @@ type StreamConfig struct {
 	MaxBytes     int64
 	MaxMsgSize   int32
 	Discard      string // old, new
+	Mirror       *StreamSource
+	Sources      []*StreamSource
+	Metadata     map[string]string
 }
 
 // StreamState holds stream state information
@@ -39,3 +44,60 @@ type StreamState struct {
 	NumDeleted   uint64
 }
 
+
+// StreamSource describes a mirror or source upstream of a stream.
+// Lag, Active and Error are the live replication status from stream info.
+type StreamSource struct {
+	Name              string
+	FilterSubject     string
+	SubjectTransforms []SubjectTransform
+	APIPrefix         string // External JetStream API prefix (other account or domain)
+	DeliverPrefix     string
+	Domain            string // Derived from an API prefix of the form $JS.<domain>.API
+	Lag               uint64
+	Active            time.Duration // Time since the last message was received, negative if never
+	Error             string
+}
+
+// SubjectTransform maps subjects matching Source to Destination
+type SubjectTransform struct {
+	Source      string
+	Destination string
+}
+
+// StreamPatch is a partial stream configuration. Nil and empty fields are left as they are.
+type StreamPatch struct {
+	MaxMessages *int64         // -1 for unlimited
+	MaxBytes    *int64         // -1 for unlimited
+	MaxAge      *time.Duration // 0 for unlimited
+	MaxMsgSize  *int32         // -1 for unlimited
+	Replicas    *int
+	Retention   string // limits, interest, workqueue
+	Discard     string // old, new
+}
+
+// Apply returns cfg with the patch applied
+func (p StreamPatch) Apply(cfg StreamConfig) StreamConfig {
+	if p.MaxMessages != nil {
+		cfg.MaxMessages = *p.MaxMessages
+	}
+	if p.MaxBytes != nil {
+		cfg.MaxBytes = *p.MaxBytes
+	}
+	if p.MaxAge != nil {
+		cfg.MaxAge = *p.MaxAge
+	}
+	if p.MaxMsgSize != nil {
+		cfg.MaxMsgSize = *p.MaxMsgSize
+	}
+	if p.Replicas != nil {
+		cfg.Replicas = *p.Replicas
+	}
+	if p.Retention != "" {
+		cfg.Retention = p.Retention
+	}
+	if p.Discard != "" {
+		cfg.Discard = p.Discard
+	}
+	return cfg
+}
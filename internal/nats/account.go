@@ -0,0 +1,51 @@
+package nats
+
+import (
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// GetAccountInfo returns JetStream usage and limits for the connected account
+func (c *Client) GetAccountInfo() (*models.AccountInfo, error) {
+	info, err := c.js.AccountInfo(c.jsOpts()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+
+	account := &models.AccountInfo{
+		Domain:    info.Domain,
+		Usage:     convertTier(info.Tier),
+		APITotal:  info.API.Total,
+		APIErrors: info.API.Errors,
+		Tiers:     make(map[string]models.AccountTier, len(info.Tiers)),
+	}
+	for name, tier := range info.Tiers {
+		account.Tiers[name] = convertTier(tier)
+	}
+
+	return account, nil
+}
+
+// convertTier converts a NATS account tier to our models.AccountTier
+func convertTier(tier nats.Tier) models.AccountTier {
+	return models.AccountTier{
+		Memory:         tier.Memory,
+		Store:          tier.Store,
+		ReservedMemory: tier.ReservedMemory,
+		ReservedStore:  tier.ReservedStore,
+		Streams:        tier.Streams,
+		Consumers:      tier.Consumers,
+		Limits: models.AccountLimits{
+			MaxMemory:            tier.Limits.MaxMemory,
+			MaxStore:             tier.Limits.MaxStore,
+			MaxStreams:           tier.Limits.MaxStreams,
+			MaxConsumers:         tier.Limits.MaxConsumers,
+			MaxAckPending:        tier.Limits.MaxAckPending,
+			MemoryMaxStreamBytes: tier.Limits.MemoryMaxStreamBytes,
+			StoreMaxStreamBytes:  tier.Limits.StoreMaxStreamBytes,
+			MaxBytesRequired:     tier.Limits.MaxBytesRequired,
+		},
+	}
+}
@@ -0,0 +1,151 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+const advisoryPrefix = "$JS.EVENT.ADVISORY."
+
+// advisory holds the union of fields used by the JetStream advisory payloads
+type advisory struct {
+	Type        string          `json:"type"`
+	ID          string          `json:"id"`
+	Time        time.Time       `json:"timestamp"`
+	Stream      string          `json:"stream"`
+	Consumer    string          `json:"consumer"`
+	StreamSeq   uint64          `json:"stream_seq"`
+	ConsumerSeq uint64          `json:"consumer_seq"`
+	Deliveries  uint64          `json:"deliveries"`
+	Reason      string          `json:"reason"`
+	Action      string          `json:"action"`
+	Leader      json.RawMessage `json:"leader"`
+	Paused      bool            `json:"paused"`
+	Subject     string          `json:"subject"`
+	Client      struct {
+		Name string `json:"name"`
+		User string `json:"user"`
+	} `json:"client"`
+}
+
+// SubscribeAdvisories subscribes to all JetStream advisories and calls handler
+// for each decoded event. The returned function removes the subscription.
+func (c *Client) SubscribeAdvisories(handler func(*models.Event)) (func(), error) {
+	if c.conn == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	sub, err := c.conn.Subscribe(advisoryPrefix+">", func(msg *nats.Msg) {
+		handler(decodeAdvisory(msg.Subject, msg.Data))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to advisories: %w", err)
+	}
+
+	return func() {
+		sub.Unsubscribe()
+	}, nil
+}
+
+// decodeAdvisory converts an advisory message into a readable event
+func decodeAdvisory(subject string, data []byte) *models.Event {
+	var adv advisory
+	json.Unmarshal(data, &adv)
+
+	event := &models.Event{
+		ID:         adv.ID,
+		Type:       advisoryType(subject),
+		Subject:    subject,
+		Time:       adv.Time,
+		Stream:     adv.Stream,
+		Consumer:   adv.Consumer,
+		StreamSeq:  adv.StreamSeq,
+		Deliveries: adv.Deliveries,
+		Raw:        data,
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	switch event.Type {
+	case models.EventMaxDeliveries:
+		event.Description = fmt.Sprintf("stream seq %d exceeded max deliveries (%d)", adv.StreamSeq, adv.Deliveries)
+	case models.EventMsgTerminated:
+		event.Description = fmt.Sprintf("stream seq %d terminated after %d deliveries", adv.StreamSeq, adv.Deliveries)
+		if adv.Reason != "" {
+			event.Description += ": " + adv.Reason
+		}
+	case models.EventMsgNaked:
+		event.Description = fmt.Sprintf("stream seq %d nacked (delivery %d)", adv.StreamSeq, adv.Deliveries)
+	case models.EventStreamCreated, models.EventStreamDeleted, models.EventStreamUpdated:
+		event.Description = fmt.Sprintf("stream %s", adv.Action)
+	case models.EventConsumerCreated, models.EventConsumerDeleted:
+		event.Description = fmt.Sprintf("consumer %s", adv.Action)
+	case models.EventConsumerPaused:
+		if adv.Paused {
+			event.Description = "consumer paused"
+		} else {
+			event.Description = "consumer resumed"
+		}
+	case models.EventStreamLeaderElected, models.EventConsumerLeaderElected:
+		event.Description = "new leader: " + leaderName(adv.Leader)
+	case models.EventStreamQuorumLost, models.EventConsumerQuorumLost:
+		event.Description = "quorum lost"
+	case models.EventAPIAudit:
+		event.Description = adv.Subject
+		if adv.Client.User != "" {
+			event.Description += " by " + adv.Client.User
+		}
+		if adv.Client.Name != "" {
+			event.Description += fmt.Sprintf(" (%s)", adv.Client.Name)
+		}
+	default:
+		event.Description = adv.Type
+	}
+
+	return event
+}
+
+// advisoryType maps an advisory subject to one of the models.Event* types
+func advisoryType(subject string) string {
+	tokens := strings.Split(strings.TrimPrefix(subject, advisoryPrefix), ".")
+	if len(tokens) == 0 {
+		return subject
+	}
+
+	switch tokens[0] {
+	case "API":
+		return models.EventAPIAudit
+	case "CONSUMER":
+		if len(tokens) > 1 {
+			switch tokens[1] {
+			case "MAX_DELIVERIES", "MSG_TERMINATED", "MSG_NAKED":
+				return tokens[1]
+			}
+		}
+	}
+
+	if len(tokens) > 1 {
+		return tokens[0] + "_" + tokens[1]
+	}
+	return tokens[0]
+}
+
+// leaderName extracts the leader from either a string or an object payload
+func leaderName(raw json.RawMessage) string {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		return name
+	}
+
+	var obj struct {
+		Name string `json:"name"`
+	}
+	json.Unmarshal(raw, &obj)
+	return obj.Name
+}
@@ -0,0 +1,52 @@
+package nats
+
+import (
+	"encoding/json"
+
+	"github.com/shubhamrasal/n2s/internal/audit"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// AuditLog returns the log every mutating call of the client is recorded in
+func (c *Client) AuditLog() *audit.Log {
+	return c.auditLog
+}
+
+// recordAudit records a mutating operation and its outcome. before and after are the
+// target's configuration, nil if it has none or it couldn't be read. Failures to
+// write the log are reported by AuditLog().Err rather than failing the operation.
+func (c *Client) recordAudit(operation, target, details string, before, after any, err error) {
+	if c.auditLog == nil {
+		return
+	}
+
+	entry := models.AuditEntry{
+		Context:   c.context,
+		Operation: operation,
+		Target:    target,
+		Details:   details,
+		Before:    auditJSON(before),
+		After:     auditJSON(after),
+		Result:    models.AuditResultOK,
+	}
+	if c.conn != nil {
+		entry.Server = connectedServer(c.conn)
+	}
+	if err != nil {
+		entry.Result = models.AuditResultFailed
+		entry.Error = err.Error()
+	}
+
+	c.auditLog.Record(entry)
+}
+
+func auditJSON(v any) json.RawMessage {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -3,33 +3,46 @@ package nats
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/audit"
 	"github.com/shubhamrasal/n2s/internal/config"
+	"github.com/shubhamrasal/n2s/internal/models"
 )
 
 // Client wraps NATS connection and JetStream context
 type Client struct {
-	conn *nats.Conn
-	js   nats.JetStreamContext
+	conn      *nats.Conn
+	js        nats.JetStreamContext
+	apiPrefix string // JetStream API subject prefix, including the trailing dot
+	events    *connEventLog
+	ctx       context.Context // Bounds JetStream API calls, see WithContext
+	context   string          // Name of the config context, for the audit log
+	auditLog  *audit.Log
 }
 
 // NewClient creates a new NATS client with JetStream enabled
 func NewClient(ctx *config.Context) (*Client, error) {
+	client := &Client{
+		events:   &connEventLog{},
+		context:  ctx.Name,
+		auditLog: audit.Default(),
+	}
+
 	// Build connection options
 	opts := []nats.Option{
-		nats.Timeout(10 * time.Second),
-		nats.MaxReconnects(5),
-		nats.ReconnectWait(2 * time.Second),
-		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
-			if err != nil {
-				// Log disconnect error (in production, use proper logging)
-			}
-		}),
-		nats.ReconnectHandler(func(nc *nats.Conn) {
-			// Log reconnect (in production, use proper logging)
-		}),
+		nats.Name(ctx.GetConnectionName()),
+		nats.Timeout(ctx.GetConnectTimeout()),
+		nats.MaxReconnects(ctx.GetMaxReconnects()),
+		nats.ReconnectWait(ctx.GetReconnectWait()),
+	}
+	opts = append(opts, client.eventHandlers()...)
+
+	// Custom inbox prefix, required when permissions restrict _INBOX.>
+	if ctx.InboxPrefix != "" {
+		opts = append(opts, nats.CustomInboxPrefix(ctx.InboxPrefix))
 	}
 
 	// Add token authentication if provided
@@ -42,23 +55,96 @@ func NewClient(ctx *config.Context) (*Client, error) {
 		opts = append(opts, nats.UserCredentials(ctx.Creds))
 	}
 
+	// Add user/password authentication if provided
+	if ctx.User != "" {
+		opts = append(opts, nats.UserInfo(ctx.User, ctx.Password))
+	}
+
+	// Add NKey seed file if provided
+	if ctx.NKey != "" {
+		nkeyOpt, err := nats.NkeyOptionFromSeed(ctx.NKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load nkey seed: %w", err)
+		}
+		opts = append(opts, nkeyOpt)
+	}
+
+	// Add TLS client certificate, CA bundle and handshake mode if provided
+	if ctx.TLSCert != "" || ctx.TLSKey != "" {
+		if ctx.TLSCert == "" || ctx.TLSKey == "" {
+			return nil, fmt.Errorf("both tls_cert and tls_key are required for client certificates")
+		}
+		opts = append(opts, nats.ClientCert(ctx.TLSCert, ctx.TLSKey))
+	}
+	if ctx.TLSCA != "" {
+		opts = append(opts, nats.RootCAs(ctx.TLSCA))
+	}
+	if ctx.TLSFirst {
+		opts = append(opts, nats.TLSHandshakeFirst())
+	}
+
 	// Connect with options
-	nc, err := nats.Connect(ctx.Server, opts...)
+	nc, err := nats.Connect(ctx.ServerURLs(), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
 	}
 
-	// Create JetStream context
-	js, err := nc.JetStream()
+	// Create JetStream context, optionally in another domain or behind an imported API prefix
+	apiPrefix := jsAPIPrefix
+	var jsOpts []nats.JSOpt
+	switch {
+	case ctx.JetStreamDomain != "" && ctx.JetStreamAPIPrefix != "":
+		nc.Close()
+		return nil, fmt.Errorf("jetstream_domain and jetstream_api_prefix are mutually exclusive")
+	case ctx.JetStreamDomain != "":
+		jsOpts = append(jsOpts, nats.Domain(ctx.JetStreamDomain))
+		apiPrefix = fmt.Sprintf("$JS.%s.API.", ctx.JetStreamDomain)
+	case ctx.JetStreamAPIPrefix != "":
+		jsOpts = append(jsOpts, nats.APIPrefix(ctx.JetStreamAPIPrefix))
+		apiPrefix = strings.TrimSuffix(ctx.JetStreamAPIPrefix, ".") + "."
+	}
+
+	js, err := nc.JetStream(jsOpts...)
 	if err != nil {
 		nc.Close()
 		return nil, fmt.Errorf("failed to create JetStream context: %w", err)
 	}
 
-	return &Client{
-		conn: nc,
-		js:   js,
-	}, nil
+	client.conn = nc
+	client.js = js
+	client.apiPrefix = apiPrefix
+	client.events.add(models.ConnEvent{
+		Time:   time.Now(),
+		Type:   models.ConnEventConnected,
+		Server: connectedServer(nc),
+	})
+
+	return client, nil
+}
+
+// WithContext returns a client sharing the same connection whose JetStream API
+// calls are cancelled when ctx is done. Use it for background refreshes.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	bound := *c
+	bound.ctx = ctx
+	return &bound
+}
+
+// jsOpts returns the options binding JetStream API calls to the client's context
+func (c *Client) jsOpts() []nats.JSOpt {
+	if c.ctx == nil {
+		return nil
+	}
+	return []nats.JSOpt{nats.Context(c.ctx)}
+}
+
+// ctxErr returns the error of the client's context, if it has one and it is done.
+// Listing calls use it to tell a cancelled listing from a complete one.
+func (c *Client) ctxErr() error {
+	if c.ctx == nil {
+		return nil
+	}
+	return c.ctx.Err()
 }
 
 // Close closes the NATS connection
@@ -73,6 +159,11 @@ func (c *Client) IsConnected() bool {
 	return c.conn != nil && c.conn.IsConnected()
 }
 
+// APIPrefix returns the JetStream API subject prefix of the context, including the trailing dot
+func (c *Client) APIPrefix() string {
+	return c.apiPrefix
+}
+
 // Stats returns connection statistics
 func (c *Client) Stats() nats.Statistics {
 	if c.conn != nil {
@@ -81,18 +172,22 @@ func (c *Client) Stats() nats.Statistics {
 	return nats.Statistics{}
 }
 
-// ServerInfo returns NATS server information
+// ServerInfo returns the URL and name of the server the client is currently connected to
 func (c *Client) ServerInfo() (string, error) {
 	if c.conn == nil {
 		return "", fmt.Errorf("not connected")
 	}
 
-	servers := c.conn.Servers()
-	if len(servers) > 0 {
-		return servers[0], nil
+	url := c.conn.ConnectedUrlRedacted()
+	if url == "" {
+		return "", fmt.Errorf("not connected")
+	}
+
+	if name := c.conn.ConnectedServerName(); name != "" {
+		return fmt.Sprintf("%s (%s)", url, name), nil
 	}
 
-	return "unknown", nil
+	return url, nil
 }
 
 // Ping checks if the connection is alive
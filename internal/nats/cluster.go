@@ -0,0 +1,94 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+const (
+	// jsAPIPrefix is the default JetStream API prefix without a domain
+	jsAPIPrefix = "$JS.API."
+
+	// apiTimeout is used for JetStream API requests without a dedicated nats.go helper
+	apiTimeout = 5 * time.Second
+)
+
+// apiResponse is the common envelope of JetStream API responses
+type apiResponse struct {
+	Success bool `json:"success"`
+	Error   *struct {
+		Code        int    `json:"code"`
+		ErrCode     int    `json:"err_code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// StepDownStreamLeader asks the current stream leader to step down so a new leader is elected
+func (c *Client) StepDownStreamLeader(streamName string) error {
+	err := c.apiRequest(fmt.Sprintf("STREAM.LEADER.STEPDOWN.%s", streamName), nil)
+	c.recordAudit(models.AuditStreamLeaderStepDown, streamName, "", nil, nil, err)
+	return err
+}
+
+// StepDownConsumerLeader asks the current consumer leader to step down so a new leader is elected
+func (c *Client) StepDownConsumerLeader(streamName, consumerName string) error {
+	err := c.apiRequest(fmt.Sprintf("CONSUMER.LEADER.STEPDOWN.%s.%s", streamName, consumerName), nil)
+	c.recordAudit(models.AuditConsumerLeaderStepDown, streamName+"/"+consumerName, "", nil, nil, err)
+	return err
+}
+
+// apiRequest sends a request to a JetStream API endpoint and checks the response.
+// body may be nil for endpoints that take no request.
+func (c *Client) apiRequest(endpoint string, body []byte) error {
+	if c.conn == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	msg, err := c.conn.Request(c.apiPrefix+endpoint, body, apiTimeout)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+
+	var resp apiResponse
+	if err := json.Unmarshal(msg.Data, &resp); err != nil {
+		return fmt.Errorf("invalid response: %w", err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s (code %d)", resp.Error.Description, resp.Error.ErrCode)
+	}
+
+	return nil
+}
+
+// convertCluster converts NATS cluster info to our models.ClusterInfo
+func convertCluster(info *nats.ClusterInfo) *models.ClusterInfo {
+	if info == nil {
+		return nil
+	}
+
+	cluster := &models.ClusterInfo{
+		Name:   info.Name,
+		Leader: info.Leader,
+	}
+	if info.LeaderSince != nil {
+		cluster.LeaderSince = *info.LeaderSince
+	}
+	for _, peer := range info.Replicas {
+		if peer == nil {
+			continue
+		}
+		cluster.Replicas = append(cluster.Replicas, models.PeerInfo{
+			Name:    peer.Name,
+			Current: peer.Current,
+			Offline: peer.Offline,
+			Active:  peer.Active,
+			Lag:     peer.Lag,
+		})
+	}
+
+	return cluster
+}
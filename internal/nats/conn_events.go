@@ -0,0 +1,120 @@
+package nats
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// maxConnEvents is the number of connection events kept in memory
+const maxConnEvents = 500
+
+// connEventLog records connection events raised by nats.go callbacks
+type connEventLog struct {
+	mu       sync.Mutex
+	events   []models.ConnEvent
+	listener func(models.ConnEvent)
+}
+
+func (l *connEventLog) add(event models.ConnEvent) {
+	l.mu.Lock()
+	l.events = append(l.events, event)
+	if len(l.events) > maxConnEvents {
+		l.events = l.events[len(l.events)-maxConnEvents:]
+	}
+	listener := l.listener
+	l.mu.Unlock()
+
+	if listener != nil {
+		listener(event)
+	}
+}
+
+// eventHandlers returns the nats.go options that feed the connection event log
+func (c *Client) eventHandlers() []nats.Option {
+	record := func(nc *nats.Conn, eventType, detail string) {
+		c.events.add(models.ConnEvent{
+			Time:   time.Now(),
+			Type:   eventType,
+			Server: connectedServer(nc),
+			Detail: detail,
+		})
+	}
+
+	return []nats.Option{
+		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
+			detail := ""
+			if err != nil {
+				detail = err.Error()
+			}
+			record(nc, models.ConnEventDisconnected, detail)
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			record(nc, models.ConnEventReconnected, fmt.Sprintf("reconnect #%d", nc.Stats().Reconnects))
+		}),
+		nats.ClosedHandler(func(nc *nats.Conn) {
+			detail := "connection closed"
+			if err := nc.LastError(); err != nil {
+				detail = err.Error()
+			}
+			record(nc, models.ConnEventClosed, detail)
+		}),
+		nats.LameDuckModeHandler(func(nc *nats.Conn) {
+			record(nc, models.ConnEventLameDuck, "server is shutting down, clients will be moved")
+		}),
+		nats.DiscoveredServersHandler(func(nc *nats.Conn) {
+			record(nc, models.ConnEventDiscovered, strings.Join(nc.DiscoveredServers(), ", "))
+		}),
+		nats.ErrorHandler(func(nc *nats.Conn, sub *nats.Subscription, err error) {
+			eventType := models.ConnEventAsyncError
+			if errors.Is(err, nats.ErrSlowConsumer) {
+				eventType = models.ConnEventSlowConsumer
+			}
+			detail := err.Error()
+			if sub != nil {
+				detail = fmt.Sprintf("%s (subscription %s)", detail, sub.Subject)
+			}
+			record(nc, eventType, detail)
+		}),
+	}
+}
+
+// connectedServer describes the server nc is currently connected to
+func connectedServer(nc *nats.Conn) string {
+	url := nc.ConnectedUrlRedacted()
+	if name := nc.ConnectedServerName(); name != "" && url != "" {
+		return fmt.Sprintf("%s (%s)", url, name)
+	}
+	return url
+}
+
+// ConnectionEvents returns the recorded connection events, oldest first
+func (c *Client) ConnectionEvents() []models.ConnEvent {
+	c.events.mu.Lock()
+	defer c.events.mu.Unlock()
+
+	events := make([]models.ConnEvent, len(c.events.events))
+	copy(events, c.events.events)
+	return events
+}
+
+// OnConnectionEvent registers a function called for every new connection event (nil to remove).
+// It is called from nats.go callback goroutines.
+func (c *Client) OnConnectionEvent(listener func(models.ConnEvent)) {
+	c.events.mu.Lock()
+	defer c.events.mu.Unlock()
+	c.events.listener = listener
+}
+
+// Status returns the connection state: CONNECTED, RECONNECTING, DISCONNECTED or CLOSED
+func (c *Client) Status() string {
+	if c.conn == nil {
+		return nats.CLOSED.String()
+	}
+	return c.conn.Status().String()
+}
@@ -0,0 +1,124 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// connzLimit is the number of connections requested per page; servers with more
+// connections are paged with an offset
+const connzLimit = 1024
+
+type connz struct {
+	Total       int `json:"total"`
+	Connections []struct {
+		CID           uint64    `json:"cid"`
+		IP            string    `json:"ip"`
+		Port          int       `json:"port"`
+		Start         time.Time `json:"start"`
+		LastActivity  time.Time `json:"last_activity"`
+		RTT           string    `json:"rtt"`
+		Uptime        string    `json:"uptime"`
+		PendingBytes  int       `json:"pending_bytes"`
+		InMsgs        int64     `json:"in_msgs"`
+		OutMsgs       int64     `json:"out_msgs"`
+		InBytes       int64     `json:"in_bytes"`
+		OutBytes      int64     `json:"out_bytes"`
+		NumSubs       uint32    `json:"subscriptions"`
+		Name          string    `json:"name"`
+		Lang          string    `json:"lang"`
+		Version       string    `json:"version"`
+		Account       string    `json:"account"`
+		User          string    `json:"authorized_user"`
+		Subscriptions []string  `json:"subscriptions_list"`
+	} `json:"connections"`
+}
+
+// GetConnections returns the client connections of every server, including their subscriptions.
+// This requires the connection to use system account credentials.
+func (c *Client) GetConnections(timeout time.Duration) ([]*models.Connection, error) {
+	if c.conn == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	responses, err := c.pingServers("CONNZ", connzPayload(0), timeout)
+	if err != nil {
+		return nil, err
+	}
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("no servers responded (system account credentials are required)")
+	}
+
+	var connections []*models.Connection
+	for _, resp := range responses {
+		if resp.Error != nil {
+			return nil, fmt.Errorf("%s: %s", resp.Server.Name, resp.Error.Description)
+		}
+
+		var data connz
+		if err := json.Unmarshal(resp.Data, &data); err != nil {
+			return nil, fmt.Errorf("failed to decode CONNZ from %s: %w", resp.Server.Name, err)
+		}
+
+		// Fetch the remaining pages from the server directly
+		for offset := len(data.Connections); offset < data.Total; {
+			page, err := c.requestServer(resp.Server.ID, "CONNZ", connzPayload(offset), timeout)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", resp.Server.Name, err)
+			}
+			if page.Error != nil {
+				return nil, fmt.Errorf("%s: %s", resp.Server.Name, page.Error.Description)
+			}
+			var next connz
+			if err := json.Unmarshal(page.Data, &next); err != nil {
+				return nil, fmt.Errorf("failed to decode CONNZ from %s: %w", resp.Server.Name, err)
+			}
+			if len(next.Connections) == 0 {
+				// Connections closed while paging
+				break
+			}
+			data.Connections = append(data.Connections, next.Connections...)
+			offset += len(next.Connections)
+		}
+
+		for _, conn := range data.Connections {
+			rtt, _ := time.ParseDuration(conn.RTT)
+			connections = append(connections, &models.Connection{
+				CID:           conn.CID,
+				Server:        resp.Server.Name,
+				Name:          conn.Name,
+				IP:            conn.IP,
+				Port:          conn.Port,
+				Account:       conn.Account,
+				User:          conn.User,
+				Lang:          conn.Lang,
+				Version:       conn.Version,
+				Start:         conn.Start,
+				LastActivity:  conn.LastActivity,
+				RTT:           rtt,
+				Uptime:        conn.Uptime,
+				PendingBytes:  conn.PendingBytes,
+				InMsgs:        conn.InMsgs,
+				OutMsgs:       conn.OutMsgs,
+				InBytes:       conn.InBytes,
+				OutBytes:      conn.OutBytes,
+				NumSubs:       conn.NumSubs,
+				Subscriptions: conn.Subscriptions,
+			})
+		}
+	}
+
+	return connections, nil
+}
+
+func connzPayload(offset int) []byte {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"subscriptions": true,
+		"offset":        offset,
+		"limit":         connzLimit,
+	})
+	return payload
+}
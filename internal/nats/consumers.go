@@ -1,7 +1,10 @@
 package nats
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -12,17 +15,56 @@ import (
 func (c *Client) ListConsumers(streamName string) ([]*models.Consumer, error) {
 	var consumers []*models.Consumer
 
-	for info := range c.js.ConsumersInfo(streamName) {
+	for info := range c.js.ConsumersInfo(streamName, c.jsOpts()...) {
 		consumer := convertConsumerInfo(info)
 		consumers = append(consumers, consumer)
 	}
+	if err := c.ctxErr(); err != nil {
+		return nil, fmt.Errorf("failed to list consumers: %w", err)
+	}
 
 	return consumers, nil
 }
 
+// ListConsumersOf lists the consumers of several streams concurrently, keyed by
+// stream. Streams deleted since they were listed are left out of the result.
+func (c *Client) ListConsumersOf(streams []string) (map[string][]*models.Consumer, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		result   = make(map[string][]*models.Consumer, len(streams))
+		firstErr error
+	)
+
+	sem := make(chan struct{}, streamInfoWorkers)
+	for _, stream := range streams {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(stream string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			consumers, err := c.ListConsumers(stream)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				result[stream] = consumers
+			case errors.Is(err, nats.ErrStreamNotFound):
+			case firstErr == nil:
+				firstErr = err
+			}
+		}(stream)
+	}
+	wg.Wait()
+
+	return result, firstErr
+}
+
 // GetConsumerInfo returns detailed information about a consumer
 func (c *Client) GetConsumerInfo(streamName, consumerName string) (*models.Consumer, error) {
-	info, err := c.js.ConsumerInfo(streamName, consumerName)
+	info, err := c.js.ConsumerInfo(streamName, consumerName, c.jsOpts()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get consumer info: %w", err)
 	}
@@ -32,36 +74,96 @@ func (c *Client) GetConsumerInfo(streamName, consumerName string) (*models.Consu
 
 // DeleteConsumer deletes a consumer from a stream
 func (c *Client) DeleteConsumer(streamName, consumerName string) error {
+	var before any
+	if info, err := c.js.ConsumerInfo(streamName, consumerName); err == nil {
+		before = info.Config
+	}
+
 	err := c.js.DeleteConsumer(streamName, consumerName)
 	if err != nil {
-		return fmt.Errorf("failed to delete consumer: %w", err)
+		err = fmt.Errorf("failed to delete consumer: %w", err)
 	}
-	return nil
+	c.recordAudit(models.AuditConsumerDelete, streamName+"/"+consumerName, "", before, nil, err)
+	return err
 }
 
 // UpdateConsumer updates consumer configuration
 func (c *Client) UpdateConsumer(streamName, consumerName string, maxDeliver, maxAckPending int, ackWait time.Duration) error {
+	return c.PatchConsumer(streamName, consumerName, models.ConsumerPatch{
+		MaxDeliver:    &maxDeliver,
+		MaxAckPending: &maxAckPending,
+		AckWait:       &ackWait,
+	})
+}
+
+// PatchConsumer changes the settings set in the patch, keeping the rest of the
+// consumer's configuration
+func (c *Client) PatchConsumer(streamName, consumerName string, patch models.ConsumerPatch) error {
 	// Get current consumer config
 	info, err := c.js.ConsumerInfo(streamName, consumerName)
 	if err != nil {
-		return fmt.Errorf("failed to get current consumer config: %w", err)
+		err = fmt.Errorf("failed to get current consumer config: %w", err)
+		c.recordAudit(models.AuditConsumerUpdate, streamName+"/"+consumerName, "", nil, nil, err)
+		return err
 	}
-	
+
 	// Update the config with new values
+	before := info.Config
 	cfg := &info.Config
-	cfg.MaxDeliver = maxDeliver
-	cfg.MaxAckPending = maxAckPending
-	cfg.AckWait = ackWait
-	
+	if patch.MaxDeliver != nil {
+		cfg.MaxDeliver = *patch.MaxDeliver
+	}
+	if patch.MaxAckPending != nil {
+		cfg.MaxAckPending = *patch.MaxAckPending
+	}
+	if patch.AckWait != nil {
+		cfg.AckWait = *patch.AckWait
+	}
+
 	// Update the consumer
-	_, err = c.js.UpdateConsumer(streamName, cfg)
+	target := streamName + "/" + consumerName
+	updated, err := c.js.UpdateConsumer(streamName, cfg)
 	if err != nil {
-		return fmt.Errorf("failed to update consumer: %w", err)
+		err = fmt.Errorf("failed to update consumer: %w", err)
+		c.recordAudit(models.AuditConsumerUpdate, target, "", before, cfg, err)
+		return err
 	}
-	
+
+	c.recordAudit(models.AuditConsumerUpdate, target, "", before, updated.Config, nil)
 	return nil
 }
 
+// PauseConsumer stops a consumer from delivering messages until the given time, or
+// resumes it if until is zero. Pausing needs nats-server 2.11 or later.
+func (c *Client) PauseConsumer(streamName, consumerName string, until time.Time) error {
+	var body []byte
+	if !until.IsZero() {
+		var err error
+		body, err = json.Marshal(struct {
+			PauseUntil time.Time `json:"pause_until"`
+		}{until.UTC()})
+		if err != nil {
+			return err
+		}
+	}
+
+	operation, details := models.AuditConsumerResume, ""
+	if !until.IsZero() {
+		operation, details = models.AuditConsumerPause, "until "+until.UTC().Format(time.RFC3339)
+	}
+
+	err := c.apiRequest(fmt.Sprintf("CONSUMER.PAUSE.%s.%s", streamName, consumerName), body)
+	if err != nil {
+		if until.IsZero() {
+			err = fmt.Errorf("failed to resume consumer: %w", err)
+		} else {
+			err = fmt.Errorf("failed to pause consumer: %w", err)
+		}
+	}
+	c.recordAudit(operation, streamName+"/"+consumerName, details, nil, nil, err)
+	return err
+}
+
 // convertConsumerInfo converts NATS ConsumerInfo to our models.Consumer
 func convertConsumerInfo(info *nats.ConsumerInfo) *models.Consumer {
 	deliverPolicy := "all"
@@ -114,19 +216,21 @@ func convertConsumerInfo(info *nats.ConsumerInfo) *models.Consumer {
 			Last:     ackFloorLast,
 		},
 		Config: models.ConsumerConfig{
-			Name:          info.Config.Name,
-			Durable:       info.Config.Durable,
-			FilterSubject: info.Config.FilterSubject,
-			DeliverPolicy: deliverPolicy,
-			AckPolicy:     ackPolicy,
-			AckWait:       info.Config.AckWait,
-			MaxDeliver:    info.Config.MaxDeliver,
-			ReplayPolicy:  replayPolicy,
-			SampleFreq:    "",
-			MaxAckPending: info.Config.MaxAckPending,
-			FlowControl:   info.Config.FlowControl,
-			Heartbeat:     info.Config.Heartbeat,
+			Name:           info.Config.Name,
+			Durable:        info.Config.Durable,
+			FilterSubject:  info.Config.FilterSubject,
+			DeliverSubject: info.Config.DeliverSubject,
+			DeliverPolicy:  deliverPolicy,
+			AckPolicy:      ackPolicy,
+			AckWait:        info.Config.AckWait,
+			MaxDeliver:     info.Config.MaxDeliver,
+			ReplayPolicy:   replayPolicy,
+			SampleFreq:     "",
+			MaxAckPending:  info.Config.MaxAckPending,
+			FlowControl:    info.Config.FlowControl,
+			Heartbeat:      info.Config.Heartbeat,
 		},
+		Cluster: convertCluster(info.Cluster),
 	}
 }
 
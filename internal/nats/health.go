@@ -0,0 +1,90 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// An RTT counts as a spike above this multiple of the moving average (and minimum)
+const (
+	rttSpikeFactor = 3
+	rttSpikeMin    = 50 * time.Millisecond
+	rttSlow        = time.Second
+)
+
+// Health is the result of a single connection health probe
+type Health struct {
+	Server   string
+	RTT      time.Duration
+	InMsgs   float64 // Per second since the previous probe
+	OutMsgs  float64
+	InBytes  float64
+	OutBytes float64
+	Warning  string // Set on flush failures and RTT spikes
+}
+
+// HealthProbe measures RTT with a flush round trip and traffic rates from connection statistics
+type HealthProbe struct {
+	client    *Client
+	lastStats nats.Statistics
+	lastTime  time.Time
+	avgRTT    time.Duration
+}
+
+// Reset forgets the previous probes, e.g. when the client is replaced
+func (p *HealthProbe) Reset() {
+	*p = HealthProbe{}
+}
+
+// Probe measures the connection once. State is reset when a different client is probed.
+func (p *HealthProbe) Probe(client *Client) Health {
+	if client != p.client {
+		*p = HealthProbe{client: client}
+	}
+
+	var health Health
+	health.Server, _ = client.ServerInfo()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Ping(ctx)
+	health.RTT = time.Since(start)
+
+	switch {
+	case err != nil:
+		health.Warning = fmt.Sprintf("flush failed: %v", err)
+	case health.RTT > rttSlow:
+		health.Warning = "slow round trip"
+	case p.avgRTT > 0 && health.RTT > rttSpikeMin && health.RTT > p.avgRTT*rttSpikeFactor:
+		health.Warning = fmt.Sprintf("RTT spike (avg %s)", p.avgRTT.Round(time.Microsecond))
+	}
+
+	// Exponential moving average of successful probes
+	if err == nil {
+		if p.avgRTT == 0 {
+			p.avgRTT = health.RTT
+		} else {
+			p.avgRTT = (p.avgRTT*4 + health.RTT) / 5
+		}
+	}
+
+	// Rates since the previous probe; counters restart after a context switch
+	stats := client.Stats()
+	now := time.Now()
+	if !p.lastTime.IsZero() && stats.InMsgs >= p.lastStats.InMsgs && stats.OutMsgs >= p.lastStats.OutMsgs {
+		elapsed := now.Sub(p.lastTime).Seconds()
+		health.InMsgs = float64(stats.InMsgs-p.lastStats.InMsgs) / elapsed
+		health.OutMsgs = float64(stats.OutMsgs-p.lastStats.OutMsgs) / elapsed
+		health.InBytes = float64(stats.InBytes-p.lastStats.InBytes) / elapsed
+		health.OutBytes = float64(stats.OutBytes-p.lastStats.OutBytes) / elapsed
+	}
+	p.lastStats = stats
+	p.lastTime = now
+
+	return health
+}
@@ -0,0 +1,221 @@
+package nats
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// ImportOptions controls how archived messages are republished
+type ImportOptions struct {
+	Subject         string  // Publish every message to this subject (empty = original subject)
+	Stream          string  // Require messages to land in this stream (empty = any)
+	RewriteFrom     string  // Subject prefix to replace
+	RewriteTo       string  // Replacement for RewriteFrom
+	SubjectPrefix   string  // Prepended to the resulting subject (e.g. "dlq")
+	PreserveHeaders bool    // Copy original headers onto the new message
+	SetMsgID        bool    // Set Nats-Msg-Id so re-running an import is idempotent
+	Rate            float64 // Max messages per second (0 = unlimited)
+	ReplayOriginal  bool    // Keep the original inter-message timing
+}
+
+// ImportResult summarizes an import run
+type ImportResult struct {
+	Published int
+	Failed    int
+	Errors    []string
+}
+
+// ReadArchivedMessages parses a JSONL file with one message per line
+func ReadArchivedMessages(r io.Reader) ([]*models.ArchivedMessage, error) {
+	var messages []*models.ArchivedMessage
+
+	scanner := bufio.NewScanner(r)
+	// Allow large payloads (up to 64MB per line)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 64*1024*1024)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var msg models.ArchivedMessage
+		if err := json.Unmarshal([]byte(text), &msg); err != nil {
+			return nil, fmt.Errorf("invalid message on line %d: %w", line, err)
+		}
+		if msg.Subject == "" {
+			return nil, fmt.Errorf("message on line %d has no subject", line)
+		}
+		messages = append(messages, &msg)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read messages: %w", err)
+	}
+
+	return messages, nil
+}
+
+// TargetSubject returns the subject a message is published to
+func (o ImportOptions) TargetSubject(original string) string {
+	subject := original
+	if o.Subject != "" {
+		subject = o.Subject
+	} else if o.RewriteFrom != "" && strings.HasPrefix(original, o.RewriteFrom) {
+		subject = o.RewriteTo + strings.TrimPrefix(original, o.RewriteFrom)
+	}
+
+	if o.SubjectPrefix != "" {
+		subject = strings.TrimSuffix(o.SubjectPrefix, ".") + "." + subject
+	}
+	return subject
+}
+
+// Validate rejects options that would be silently ignored or can't be applied
+func (o ImportOptions) Validate() error {
+	if o.RewriteFrom != "" && o.RewriteTo == "" {
+		return fmt.Errorf("rewrite from %q needs a rewrite to", o.RewriteFrom)
+	}
+	if o.RewriteTo != "" && o.RewriteFrom == "" {
+		return fmt.Errorf("rewrite to %q needs a rewrite from", o.RewriteTo)
+	}
+	if o.Rate < 0 {
+		return fmt.Errorf("invalid rate %g", o.Rate)
+	}
+	return nil
+}
+
+// ImportMessages republishes archived messages according to opts.
+// progress is called after every message with the running count and the publish error, if any.
+// The import is recorded in the audit log as a whole.
+func (c *Client) ImportMessages(ctx context.Context, messages []*models.ArchivedMessage, opts ImportOptions, progress func(done, total int, err error)) (*ImportResult, error) {
+	result, err := c.importMessages(ctx, messages, opts, progress)
+
+	target := opts.Stream
+	if target == "" {
+		target = opts.Subject
+	}
+	details := fmt.Sprintf("%d of %d messages published, %d failed", result.Published, len(messages), result.Failed)
+	auditErr := err
+	if auditErr == nil && result.Failed > 0 {
+		auditErr = fmt.Errorf("%d messages failed to publish", result.Failed)
+	}
+	c.recordAudit(models.AuditMessagesImport, target, details, nil, nil, auditErr)
+
+	return result, err
+}
+
+func (c *Client) importMessages(ctx context.Context, messages []*models.ArchivedMessage, opts ImportOptions, progress func(done, total int, err error)) (*ImportResult, error) {
+	result := &ImportResult{}
+	if err := opts.Validate(); err != nil {
+		return result, err
+	}
+
+	var interval time.Duration
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+
+	var lastSent time.Time
+	for i, msg := range messages {
+		// Pace the publish according to the original timing or the rate limit
+		var wait time.Duration
+		if opts.ReplayOriginal && i > 0 && !msg.Timestamp.IsZero() && !messages[i-1].Timestamp.IsZero() {
+			wait = msg.Timestamp.Sub(messages[i-1].Timestamp)
+		}
+		if interval > wait {
+			wait = interval
+		}
+		if i > 0 && wait > 0 {
+			if remaining := wait - time.Since(lastSent); remaining > 0 {
+				select {
+				case <-ctx.Done():
+					return result, ctx.Err()
+				case <-time.After(remaining):
+				}
+			}
+		}
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		lastSent = time.Now()
+		err := c.publishArchived(msg, opts)
+		if err != nil {
+			result.Failed++
+			result.Errors = append(result.Errors, fmt.Sprintf("seq %d (%s): %v", msg.Sequence, msg.Subject, err))
+		} else {
+			result.Published++
+		}
+
+		if progress != nil {
+			progress(i+1, len(messages), err)
+		}
+	}
+
+	return result, nil
+}
+
+// publishArchived republishes a single archived message according to opts
+func (c *Client) publishArchived(msg *models.ArchivedMessage, opts ImportOptions) error {
+	var headers map[string][]string
+	if opts.PreserveHeaders {
+		headers = stripJetStreamHeaders(msg.Headers)
+	}
+
+	msgID := ""
+	if opts.SetMsgID {
+		msgID = archivedMsgID(msg)
+	}
+
+	_, err := c.PublishMessage(opts.TargetSubject(msg.Subject), msg.Data, headers, msgID, opts.Stream)
+	return err
+}
+
+// archivedMsgID derives a stable Nats-Msg-Id for a message.
+// An existing ID is kept; otherwise the origin stream/sequence or a content hash is used.
+func archivedMsgID(msg *models.ArchivedMessage) string {
+	if ids := msg.Headers[nats.MsgIdHdr]; len(ids) > 0 && ids[0] != "" {
+		return ids[0]
+	}
+	if msg.Stream != "" && msg.Sequence > 0 {
+		return fmt.Sprintf("%s:%d", msg.Stream, msg.Sequence)
+	}
+
+	h := sha256.New()
+	h.Write([]byte(msg.Subject))
+	h.Write([]byte{0})
+	h.Write(msg.Data)
+	h.Write([]byte(msg.Timestamp.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// stripJetStreamHeaders drops headers that would make the server reject or
+// reinterpret a republished message (expectations, rollups, etc.)
+func stripJetStreamHeaders(headers map[string][]string) map[string][]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	out := make(map[string][]string, len(headers))
+	for k, vals := range headers {
+		if strings.HasPrefix(k, "Nats-Expected-") || k == nats.MsgRollup || k == nats.MsgIdHdr {
+			continue
+		}
+		out[k] = vals
+	}
+	return out
+}
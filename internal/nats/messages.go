@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/nats-io/nats.go"
 	"github.com/shubhamrasal/n2s/internal/models"
 )
 
@@ -117,3 +118,29 @@ func (c *Client) GetMessageDetail(streamName string, seq uint64) (*models.Messag
 	}, nil
 }
 
+// PublishMessage publishes a message to JetStream and waits for the ack.
+// If stream is set the server rejects the message unless it lands in that stream.
+func (c *Client) PublishMessage(subject string, data []byte, headers map[string][]string, msgID, stream string) (uint64, error) {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	for k, vals := range headers {
+		for _, val := range vals {
+			msg.Header.Add(k, val)
+		}
+	}
+
+	var opts []nats.PubOpt
+	if msgID != "" {
+		opts = append(opts, nats.MsgId(msgID))
+	}
+	if stream != "" {
+		opts = append(opts, nats.ExpectStream(stream))
+	}
+
+	ack, err := c.js.PublishMsg(msg, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return ack.Sequence, nil
+}
@@ -0,0 +1,172 @@
+package nats
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// RedriveOptions controls how messages are copied or moved out of a stream
+type RedriveOptions struct {
+	Target          ImportOptions // Destination subject/stream and subject rewriting
+	DeleteOriginals bool          // Delete each source message after its publish is acknowledged
+}
+
+// RedriveStatus reports the outcome for a single message
+type RedriveStatus struct {
+	Sequence      uint64
+	Subject       string
+	TargetSubject string
+	Published     bool
+	Deleted       bool
+	Err           error
+}
+
+// FindMessages returns the sequences in [startSeq, endSeq] whose subject matches filter.
+// An empty filter matches every subject. At most limit sequences are returned (0 = no limit).
+// The range is read with an ordered consumer filtered on the server, headers only.
+func (c *Client) FindMessages(streamName string, startSeq, endSeq uint64, filter string, limit int) ([]uint64, error) {
+	info, err := c.js.StreamInfo(streamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream info: %w", err)
+	}
+
+	if startSeq < info.State.FirstSeq {
+		startSeq = info.State.FirstSeq
+	}
+	if endSeq == 0 || endSeq > info.State.LastSeq {
+		endSeq = info.State.LastSeq
+	}
+	if info.State.Msgs == 0 || startSeq > endSeq {
+		return nil, nil
+	}
+
+	sub, err := c.js.SubscribeSync(filter,
+		nats.BindStream(streamName),
+		nats.OrderedConsumer(),
+		nats.HeadersOnly(),
+		nats.StartSequence(startSeq),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Nothing to wait for if no message matches from startSeq on
+	consumer, err := sub.ConsumerInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+	if consumer.NumPending == 0 {
+		return nil, nil
+	}
+
+	var seqs []uint64
+	for limit <= 0 || len(seqs) < limit {
+		msg, err := sub.NextMsg(apiTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read messages after seq %d: %w", startSeq, err)
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read message metadata: %w", err)
+		}
+
+		seq := meta.Sequence.Stream
+		if seq > endSeq {
+			break
+		}
+		seqs = append(seqs, seq)
+		startSeq = seq
+
+		if meta.NumPending == 0 || seq == endSeq {
+			break
+		}
+	}
+
+	return seqs, nil
+}
+
+// RedriveMessages republishes the given stream sequences according to opts.
+// progress is called once per message with its outcome. The redrive is recorded in
+// the audit log as a whole.
+func (c *Client) RedriveMessages(ctx context.Context, streamName string, seqs []uint64, opts RedriveOptions, progress func(RedriveStatus)) error {
+	var published, deleted, failed int
+	err := c.redriveMessages(ctx, streamName, seqs, opts, func(status RedriveStatus) {
+		if status.Published {
+			published++
+		}
+		if status.Deleted {
+			deleted++
+		}
+		if status.Err != nil {
+			failed++
+		}
+		progress(status)
+	})
+
+	details := fmt.Sprintf("%d of %d messages published", published, len(seqs))
+	if opts.DeleteOriginals {
+		details += fmt.Sprintf(", %d originals deleted", deleted)
+	}
+	if target := opts.Target.Stream; target != "" {
+		details += " to stream " + target
+	}
+	auditErr := err
+	if auditErr == nil && failed > 0 {
+		auditErr = fmt.Errorf("%d messages failed", failed)
+	}
+	c.recordAudit(models.AuditMessagesRedrive, streamName, details, nil, nil, auditErr)
+
+	return err
+}
+
+func (c *Client) redriveMessages(ctx context.Context, streamName string, seqs []uint64, opts RedriveOptions, progress func(RedriveStatus)) error {
+	for _, seq := range seqs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		status := RedriveStatus{Sequence: seq}
+
+		msg, err := c.js.GetMsg(streamName, seq)
+		if err != nil {
+			status.Err = fmt.Errorf("failed to get message: %w", err)
+			progress(status)
+			continue
+		}
+
+		archived := &models.ArchivedMessage{
+			Stream:    streamName,
+			Sequence:  msg.Sequence,
+			Subject:   msg.Subject,
+			Headers:   msg.Header,
+			Data:      msg.Data,
+			Timestamp: msg.Time,
+		}
+		status.Subject = msg.Subject
+		status.TargetSubject = opts.Target.TargetSubject(msg.Subject)
+
+		if err := c.publishArchived(archived, opts.Target); err != nil {
+			status.Err = err
+			progress(status)
+			continue
+		}
+		status.Published = true
+
+		// Only remove the original once the copy is safely stored
+		if opts.DeleteOriginals {
+			if err := c.deleteMessage(streamName, seq); err != nil {
+				status.Err = err
+			} else {
+				status.Deleted = true
+			}
+		}
+
+		progress(status)
+	}
+
+	return nil
+}
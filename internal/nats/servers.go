@@ -0,0 +1,214 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+const serverPingPrefix = "$SYS.REQ.SERVER.PING."
+
+// serverRequestPrefix addresses a monitoring request to a single server by ID
+const serverRequestPrefix = "$SYS.REQ.SERVER."
+
+// serverResponse is the envelope every server returns for system requests
+type serverResponse struct {
+	Server struct {
+		Name      string `json:"name"`
+		Host      string `json:"host"`
+		ID        string `json:"id"`
+		Cluster   string `json:"cluster"`
+		Version   string `json:"ver"`
+		JetStream bool   `json:"jetstream"`
+	} `json:"server"`
+	Data  json.RawMessage `json:"data"`
+	Error *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+	raw []byte
+}
+
+type varz struct {
+	Start         time.Time `json:"start"`
+	Uptime        string    `json:"uptime"`
+	CPU           float64   `json:"cpu"`
+	Mem           int64     `json:"mem"`
+	Cores         int       `json:"cores"`
+	Connections   int       `json:"connections"`
+	Routes        int       `json:"routes"`
+	Leafnodes     int       `json:"leafnodes"`
+	SlowConsumers int64     `json:"slow_consumers"`
+}
+
+type jsz struct {
+	Memory    uint64 `json:"memory"`
+	Storage   uint64 `json:"storage"`
+	Streams   int    `json:"streams"`
+	Consumers int    `json:"consumers"`
+	Config    struct {
+		MaxMemory  int64 `json:"max_memory"`
+		MaxStorage int64 `json:"max_storage"`
+	} `json:"config"`
+	MetaCluster *struct {
+		Leader string `json:"leader"`
+	} `json:"meta_cluster"`
+}
+
+type healthz struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+// GetServers pings every server in the cluster/supercluster for VARZ, JSZ and HEALTHZ.
+// This requires the connection to use system account credentials.
+func (c *Client) GetServers(timeout time.Duration) ([]*models.ServerStatus, error) {
+	if c.conn == nil {
+		return nil, fmt.Errorf("not connected")
+	}
+
+	servers := make(map[string]*models.ServerStatus)
+	server := func(resp *serverResponse) *models.ServerStatus {
+		s, ok := servers[resp.Server.ID]
+		if !ok {
+			s = &models.ServerStatus{
+				ID:        resp.Server.ID,
+				Name:      resp.Server.Name,
+				Host:      resp.Server.Host,
+				Version:   resp.Server.Version,
+				Cluster:   resp.Server.Cluster,
+				JetStream: resp.Server.JetStream,
+				Raw:       make(map[string][]byte),
+			}
+			servers[resp.Server.ID] = s
+		}
+		return s
+	}
+
+	for _, kind := range []string{models.ServerVarz, models.ServerJsz, models.ServerHealthz} {
+		responses, err := c.pingServers(kind, nil, timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resp := range responses {
+			s := server(resp)
+			s.Raw[kind] = resp.raw
+			if resp.Error != nil {
+				if kind == models.ServerHealthz {
+					s.HealthStatus = "error"
+					s.HealthError = resp.Error.Description
+				}
+				continue
+			}
+
+			switch kind {
+			case models.ServerVarz:
+				var v varz
+				json.Unmarshal(resp.Data, &v)
+				s.Start = v.Start
+				s.Uptime = v.Uptime
+				s.CPU = v.CPU
+				s.Mem = v.Mem
+				s.Cores = v.Cores
+				s.Connections = v.Connections
+				s.Routes = v.Routes
+				s.Leafnodes = v.Leafnodes
+				s.SlowConsumers = v.SlowConsumers
+			case models.ServerJsz:
+				var j jsz
+				json.Unmarshal(resp.Data, &j)
+				s.JSMemory = j.Memory
+				s.JSStore = j.Storage
+				s.JSMaxMemory = j.Config.MaxMemory
+				s.JSMaxStore = j.Config.MaxStorage
+				s.Streams = j.Streams
+				s.Consumers = j.Consumers
+				if j.MetaCluster != nil {
+					s.MetaLeader = j.MetaCluster.Leader
+				}
+			case models.ServerHealthz:
+				var h healthz
+				json.Unmarshal(resp.Data, &h)
+				s.HealthStatus = h.Status
+				s.HealthError = h.Error
+				s.Healthy = h.Status == "ok"
+			}
+		}
+
+		// Without system account access nobody answers; don't wait for the other requests
+		if len(servers) == 0 {
+			return nil, fmt.Errorf("no servers responded (system account credentials are required)")
+		}
+	}
+
+	result := make([]*models.ServerStatus, 0, len(servers))
+	for _, s := range servers {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// pingServers sends a system ping request and gathers responses from all servers.
+// Collection stops after timeout, or once servers stop responding for a short while.
+func (c *Client) pingServers(kind string, payload []byte, timeout time.Duration) ([]*serverResponse, error) {
+	inbox := c.conn.NewRespInbox()
+	sub, err := c.conn.SubscribeSync(inbox)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	if err := c.conn.PublishRequest(serverPingPrefix+kind, inbox, payload); err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", kind, err)
+	}
+
+	var responses []*serverResponse
+	deadline := time.Now().Add(timeout)
+	wait := timeout
+	for {
+		if remaining := time.Until(deadline); remaining < wait {
+			wait = remaining
+		}
+		if wait <= 0 {
+			break
+		}
+
+		msg, err := sub.NextMsg(wait)
+		if err != nil {
+			break
+		}
+
+		resp := &serverResponse{raw: msg.Data}
+		if err := json.Unmarshal(msg.Data, resp); err != nil {
+			continue
+		}
+		responses = append(responses, resp)
+
+		// After the first reply, only wait briefly for the rest of the cluster
+		wait = 300 * time.Millisecond
+	}
+
+	return responses, nil
+}
+
+// requestServer sends a monitoring request to a single server
+func (c *Client) requestServer(id, kind string, payload []byte, timeout time.Duration) (*serverResponse, error) {
+	msg, err := c.conn.Request(serverRequestPrefix+id+"."+kind, payload, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request: %w", kind, err)
+	}
+
+	resp := &serverResponse{raw: msg.Data}
+	if err := json.Unmarshal(msg.Data, resp); err != nil {
+		return nil, fmt.Errorf("failed to decode %s response: %w", kind, err)
+	}
+	return resp, nil
+}
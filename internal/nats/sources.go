@@ -0,0 +1,84 @@
+package nats
+
+import (
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// convertSources returns the mirror and sources of a stream, merging their
+// configuration with the live replication status from stream info
+func convertSources(info *nats.StreamInfo) (*models.StreamSource, []*models.StreamSource) {
+	var mirror *models.StreamSource
+	if info.Config.Mirror != nil {
+		mirror = convertSource(info.Config.Mirror, info.Mirror)
+	}
+
+	var sources []*models.StreamSource
+	for _, cfg := range info.Config.Sources {
+		if cfg == nil {
+			continue
+		}
+
+		// Match the status by upstream name and API prefix
+		var status *nats.StreamSourceInfo
+		for _, s := range info.Sources {
+			if s != nil && s.Name == cfg.Name && externalPrefix(s.External) == externalPrefix(cfg.External) {
+				status = s
+				break
+			}
+		}
+		sources = append(sources, convertSource(cfg, status))
+	}
+
+	return mirror, sources
+}
+
+func convertSource(cfg *nats.StreamSource, status *nats.StreamSourceInfo) *models.StreamSource {
+	source := &models.StreamSource{
+		Name:          cfg.Name,
+		FilterSubject: cfg.FilterSubject,
+		APIPrefix:     externalPrefix(cfg.External),
+		Domain:        cfg.Domain,
+		Active:        -1,
+	}
+	if cfg.External != nil {
+		source.DeliverPrefix = cfg.External.DeliverPrefix
+	}
+	if source.Domain == "" {
+		source.Domain = domainFromPrefix(source.APIPrefix)
+	}
+	for _, t := range cfg.SubjectTransforms {
+		source.SubjectTransforms = append(source.SubjectTransforms, models.SubjectTransform{
+			Source:      t.Source,
+			Destination: t.Destination,
+		})
+	}
+
+	if status != nil {
+		source.Lag = status.Lag
+		source.Active = status.Active
+		if status.Error != nil {
+			source.Error = status.Error.Error()
+		}
+	}
+
+	return source
+}
+
+func externalPrefix(external *nats.ExternalStream) string {
+	if external == nil {
+		return ""
+	}
+	return external.APIPrefix
+}
+
+// domainFromPrefix extracts the domain from a JetStream API prefix like $JS.hub.API
+func domainFromPrefix(prefix string) string {
+	tokens := strings.Split(prefix, ".")
+	if len(tokens) == 3 && tokens[0] == "$JS" && tokens[2] == "API" {
+		return tokens[1]
+	}
+	return ""
+}
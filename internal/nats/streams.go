@@ -1,8 +1,10 @@
 package nats
 
 import (
+	"errors"
 	"fmt"
-	"time"
+	"sort"
+	"sync"
 
 	"github.com/nats-io/nats.go"
 	"github.com/shubhamrasal/n2s/internal/models"
@@ -12,55 +14,97 @@ import (
 func (c *Client) ListStreams() ([]*models.Stream, error) {
 	var streams []*models.Stream
 
-	for info := range c.js.StreamsInfo() {
-		stream := &models.Stream{
-			Name:      info.Config.Name,
-			Subjects:  info.Config.Subjects,
-			Messages:  info.State.Msgs,
-			Bytes:     info.State.Bytes,
-			Consumers: info.State.Consumers,
-			Config: models.StreamConfig{
-				Name:        info.Config.Name,
-				Subjects:    info.Config.Subjects,
-				Retention:   info.Config.Retention.String(),
-				Storage:     info.Config.Storage.String(),
-				Replicas:    info.Config.Replicas,
-				MaxAge:      info.Config.MaxAge,
-				MaxMessages: info.Config.MaxMsgs,
-				MaxBytes:    info.Config.MaxBytes,
-				MaxMsgSize:  info.Config.MaxMsgSize,
-				Discard:     info.Config.Discard.String(),
-			},
-			State: models.StreamState{
-				Messages:   info.State.Msgs,
-				Bytes:      info.State.Bytes,
-				FirstSeq:   info.State.FirstSeq,
-				FirstTime:  info.State.FirstTime,
-				LastSeq:    info.State.LastSeq,
-				LastTime:   info.State.LastTime,
-				Consumers:  info.State.Consumers,
-				NumDeleted: uint64(info.State.NumDeleted),
-			},
-		}
-		streams = append(streams, stream)
+	for info := range c.js.StreamsInfo(c.jsOpts()...) {
+		streams = append(streams, convertStream(info))
+	}
+	if err := c.ctxErr(); err != nil {
+		return nil, fmt.Errorf("failed to list streams: %w", err)
 	}
 
 	return streams, nil
 }
 
+// ListStreamNames returns the sorted names of all streams, or only of those listening
+// on subjects overlapping subject when it is set. The server pages the names, which is
+// much cheaper than listing full stream info for accounts with thousands of streams.
+func (c *Client) ListStreamNames(subject string) ([]string, error) {
+	opts := c.jsOpts()
+	if subject != "" {
+		opts = append(opts, nats.StreamListFilter(subject))
+	}
+
+	var names []string
+	for name := range c.js.StreamNames(opts...) {
+		names = append(names, name)
+	}
+	if err := c.ctxErr(); err != nil {
+		return nil, fmt.Errorf("failed to list stream names: %w", err)
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// streamInfoWorkers limits concurrent stream info requests in GetStreamInfos
+const streamInfoWorkers = 8
+
+// GetStreamInfos fetches information about the named streams concurrently. Streams
+// deleted since they were listed are left out of the result.
+func (c *Client) GetStreamInfos(names []string) ([]*models.Stream, error) {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		streams  = make([]*models.Stream, 0, len(names))
+		firstErr error
+	)
+
+	sem := make(chan struct{}, streamInfoWorkers)
+	for _, name := range names {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(name string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			stream, err := c.GetStreamInfo(name)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				streams = append(streams, stream)
+			case errors.Is(err, nats.ErrStreamNotFound):
+			case firstErr == nil:
+				firstErr = err
+			}
+		}(name)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return streams, firstErr
+	}
+	return streams, nil
+}
+
 // GetStreamInfo returns detailed information about a stream
 func (c *Client) GetStreamInfo(name string) (*models.Stream, error) {
-	info, err := c.js.StreamInfo(name)
+	info, err := c.js.StreamInfo(name, c.jsOpts()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get stream info: %w", err)
 	}
 
+	return convertStream(info), nil
+}
+
+func convertStream(info *nats.StreamInfo) *models.Stream {
 	stream := &models.Stream{
 		Name:      info.Config.Name,
 		Subjects:  info.Config.Subjects,
 		Messages:  info.State.Msgs,
 		Bytes:     info.State.Bytes,
 		Consumers: info.State.Consumers,
+		Created:   info.Created,
 		Config: models.StreamConfig{
 			Name:        info.Config.Name,
 			Subjects:    info.Config.Subjects,
@@ -72,6 +116,7 @@ func (c *Client) GetStreamInfo(name string) (*models.Stream, error) {
 			MaxBytes:    info.Config.MaxBytes,
 			MaxMsgSize:  info.Config.MaxMsgSize,
 			Discard:     info.Config.Discard.String(),
+			Metadata:    info.Config.Metadata,
 		},
 		State: models.StreamState{
 			Messages:   info.State.Msgs,
@@ -83,46 +128,75 @@ func (c *Client) GetStreamInfo(name string) (*models.Stream, error) {
 			Consumers:  info.State.Consumers,
 			NumDeleted: uint64(info.State.NumDeleted),
 		},
+		Cluster: convertCluster(info.Cluster),
 	}
+	stream.Config.Mirror, stream.Config.Sources = convertSources(info)
 
-	return stream, nil
+	return stream
 }
 
 // DeleteStream deletes a stream
 func (c *Client) DeleteStream(name string) error {
+	// Keep the configuration in the audit log, so the stream can be recreated
+	var before any
+	if info, err := c.js.StreamInfo(name, c.jsOpts()...); err == nil {
+		before = info.Config
+	}
+
 	err := c.js.DeleteStream(name)
 	if err != nil {
-		return fmt.Errorf("failed to delete stream: %w", err)
+		err = fmt.Errorf("failed to delete stream: %w", err)
 	}
-	return nil
+	c.recordAudit(models.AuditStreamDelete, name, "", before, nil, err)
+	return err
 }
 
 // PurgeStream purges all messages from a stream
 func (c *Client) PurgeStream(name string) error {
+	details := ""
+	if info, err := c.js.StreamInfo(name, c.jsOpts()...); err == nil {
+		details = fmt.Sprintf("%d messages, %d bytes", info.State.Msgs, info.State.Bytes)
+	}
+
 	err := c.js.PurgeStream(name)
 	if err != nil {
-		return fmt.Errorf("failed to purge stream: %w", err)
+		err = fmt.Errorf("failed to purge stream: %w", err)
 	}
-	return nil
+	c.recordAudit(models.AuditStreamPurge, name, details, nil, nil, err)
+	return err
 }
 
-// UpdateStream updates stream configuration
-func (c *Client) UpdateStream(name string, maxMsgs int64, maxBytes int64, maxAge time.Duration, maxMsgSize int32, retention, discard string) error {
+// PatchStream applies a partial configuration to a stream, leaving other settings
+// as they are on the server
+func (c *Client) PatchStream(name string, patch models.StreamPatch) error {
 	// Get current stream config
-	info, err := c.js.StreamInfo(name)
+	info, err := c.js.StreamInfo(name, c.jsOpts()...)
 	if err != nil {
-		return fmt.Errorf("failed to get current stream config: %w", err)
+		err = fmt.Errorf("failed to get current stream config: %w", err)
+		c.recordAudit(models.AuditStreamUpdate, name, "", nil, nil, err)
+		return err
 	}
-	
+
 	// Update the config with new values
 	cfg := info.Config
-	cfg.MaxMsgs = maxMsgs
-	cfg.MaxBytes = maxBytes
-	cfg.MaxAge = maxAge
-	cfg.MaxMsgSize = maxMsgSize
-	
+	if patch.MaxMessages != nil {
+		cfg.MaxMsgs = *patch.MaxMessages
+	}
+	if patch.MaxBytes != nil {
+		cfg.MaxBytes = *patch.MaxBytes
+	}
+	if patch.MaxAge != nil {
+		cfg.MaxAge = *patch.MaxAge
+	}
+	if patch.MaxMsgSize != nil {
+		cfg.MaxMsgSize = *patch.MaxMsgSize
+	}
+	if patch.Replicas != nil {
+		cfg.Replicas = *patch.Replicas
+	}
+
 	// Parse retention
-	switch retention {
+	switch patch.Retention {
 	case "limits":
 		cfg.Retention = nats.LimitsPolicy
 	case "interest":
@@ -130,21 +204,24 @@ func (c *Client) UpdateStream(name string, maxMsgs int64, maxBytes int64, maxAge
 	case "workqueue":
 		cfg.Retention = nats.WorkQueuePolicy
 	}
-	
+
 	// Parse discard
-	switch discard {
+	switch patch.Discard {
 	case "old":
 		cfg.Discard = nats.DiscardOld
 	case "new":
 		cfg.Discard = nats.DiscardNew
 	}
-	
+
 	// Update the stream
-	_, err = c.js.UpdateStream(&cfg)
+	updated, err := c.js.UpdateStream(&cfg, c.jsOpts()...)
 	if err != nil {
-		return fmt.Errorf("failed to update stream: %w", err)
+		err = fmt.Errorf("failed to update stream: %w", err)
+		c.recordAudit(models.AuditStreamUpdate, name, "", info.Config, cfg, err)
+		return err
 	}
-	
+
+	c.recordAudit(models.AuditStreamUpdate, name, "", info.Config, updated.Config, nil)
 	return nil
 }
 
@@ -164,3 +241,18 @@ func (c *Client) GetMessage(streamName string, seq uint64) (*models.Message, err
 		Size:      len(msg.Data),
 	}, nil
 }
+
+// DeleteMessage removes a single message from a stream by sequence number
+func (c *Client) DeleteMessage(streamName string, seq uint64) error {
+	err := c.deleteMessage(streamName, seq)
+	c.recordAudit(models.AuditMessageDelete, streamName, fmt.Sprintf("seq %d", seq), nil, nil, err)
+	return err
+}
+
+// deleteMessage deletes a message without recording it, for operations audited as a whole
+func (c *Client) deleteMessage(streamName string, seq uint64) error {
+	if err := c.js.DeleteMsg(streamName, seq); err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// AccountView displays JetStream account usage and limits
+type AccountView struct {
+	ui       *UIManager
+	flex     *tview.Flex
+	textView *tview.TextView
+	account  *models.AccountInfo
+}
+
+// NewAccountView creates a new account view
+func NewAccountView(ui *UIManager) *AccountView {
+	view := &AccountView{
+		ui: ui,
+	}
+
+	view.textView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+
+	view.textView.SetBorder(true).
+		SetTitle(" JetStream Account ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.flex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.textView, 0, 1, true)
+
+	view.setupKeybindings()
+
+	return view
+}
+
+func (v *AccountView) setupKeybindings() {
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'r':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+// Refresh updates the account information
+func (v *AccountView) Refresh() {
+	v.ui.refresh("account", func(client *nats.Client) func() {
+		account, err := client.GetAccountInfo()
+
+		return func() {
+			if err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to get account info: %v", err))
+				return
+			}
+			v.SetAccount(account)
+		}
+	})
+}
+
+// SetAccount renders already fetched account information
+func (v *AccountView) SetAccount(account *models.AccountInfo) {
+	v.account = account
+	v.render()
+}
+
+func (v *AccountView) render() {
+	if v.account == nil {
+		return
+	}
+
+	var output strings.Builder
+
+	// Overview
+	output.WriteString("[yellow]═══ ACCOUNT OVERVIEW ═══[white]\n\n")
+	domain := v.account.Domain
+	if domain == "" {
+		domain = "(none)"
+	}
+	output.WriteString(fmt.Sprintf("[cyan]Domain:[white]        %s\n", domain))
+	output.WriteString(fmt.Sprintf("[cyan]Streams:[white]       %d / %s\n", v.account.Usage.Streams, formatLimit(int64(v.account.Usage.Limits.MaxStreams))))
+	output.WriteString(fmt.Sprintf("[cyan]Consumers:[white]     %d / %s\n\n", v.account.Usage.Consumers, formatLimit(int64(v.account.Usage.Limits.MaxConsumers))))
+
+	// Usage
+	output.WriteString("[yellow]═══ USAGE ═══[white]\n\n")
+	v.writeTierUsage(&output, v.account.Usage)
+
+	// API stats
+	output.WriteString("[yellow]═══ API ═══[white]\n\n")
+	errorRate := 0.0
+	if v.account.APITotal > 0 {
+		errorRate = float64(v.account.APIErrors) / float64(v.account.APITotal) * 100
+	}
+	errorColor := "white"
+	if v.account.APIErrors > 0 {
+		errorColor = "yellow"
+	}
+	output.WriteString(fmt.Sprintf("[cyan]Total Requests:[white] %s\n", formatNumber(v.account.APITotal)))
+	output.WriteString(fmt.Sprintf("[cyan]Errors:[white]         [%s]%s (%.2f%%)[white]\n\n", errorColor, formatNumber(v.account.APIErrors), errorRate))
+
+	// Limits
+	limits := v.account.Usage.Limits
+	output.WriteString("[yellow]═══ LIMITS ═══[white]\n\n")
+	output.WriteString(fmt.Sprintf("[cyan]Max Ack Pending:[white]          %s\n", formatLimit(int64(limits.MaxAckPending))))
+	output.WriteString(fmt.Sprintf("[cyan]Max Memory Stream Bytes:[white]  %s\n", formatByteLimit(limits.MemoryMaxStreamBytes)))
+	output.WriteString(fmt.Sprintf("[cyan]Max File Stream Bytes:[white]    %s\n", formatByteLimit(limits.StoreMaxStreamBytes)))
+	output.WriteString(fmt.Sprintf("[cyan]Max Bytes Required:[white]       %t\n\n", limits.MaxBytesRequired))
+
+	// Per-tier usage
+	if len(v.account.Tiers) > 0 {
+		names := make([]string, 0, len(v.account.Tiers))
+		for name := range v.account.Tiers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			tier := v.account.Tiers[name]
+			output.WriteString(fmt.Sprintf("[yellow]═══ TIER %s ═══[white]\n\n", name))
+			output.WriteString(fmt.Sprintf("[cyan]Streams:[white]   %d / %s\n", tier.Streams, formatLimit(int64(tier.Limits.MaxStreams))))
+			output.WriteString(fmt.Sprintf("[cyan]Consumers:[white] %d / %s\n\n", tier.Consumers, formatLimit(int64(tier.Limits.MaxConsumers))))
+			v.writeTierUsage(&output, tier)
+		}
+	}
+
+	v.textView.SetText(output.String())
+	v.ui.footer.Update("r: Refresh  Esc: Back")
+}
+
+func (v *AccountView) writeTierUsage(output *strings.Builder, tier models.AccountTier) {
+	output.WriteString("[cyan]Storage:[white]\n")
+	output.WriteString(createBar(tier.Store, uint64(tier.Limits.MaxStore), formatBytes))
+	output.WriteString(fmt.Sprintf("\n  Reserved: %s\n\n", formatBytes(tier.ReservedStore)))
+
+	output.WriteString("[cyan]Memory:[white]\n")
+	output.WriteString(createBar(tier.Memory, uint64(tier.Limits.MaxMemory), formatBytes))
+	output.WriteString(fmt.Sprintf("\n  Reserved: %s\n\n", formatBytes(tier.ReservedMemory)))
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *AccountView) GetPrimitive() tview.Primitive {
+	return v.flex
+}
+
+// accountUsage returns the most used storage resource across the account and
+// its tiers as a label and percentage, or -1 if no limits are set
+func accountUsage(account *models.AccountInfo) (string, float64) {
+	label, percent := "", -1.0
+
+	check := func(name string, used uint64, limit int64) {
+		if limit <= 0 {
+			return
+		}
+		if p := float64(used) / float64(limit) * 100; p > percent {
+			label, percent = name, p
+		}
+	}
+
+	check("file", account.Usage.Store, account.Usage.Limits.MaxStore)
+	check("mem", account.Usage.Memory, account.Usage.Limits.MaxMemory)
+	for name, tier := range account.Tiers {
+		check(name+" file", tier.Store, tier.Limits.MaxStore)
+		check(name+" mem", tier.Memory, tier.Limits.MaxMemory)
+	}
+
+	return label, percent
+}
+
+func formatLimit(limit int64) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", limit)
+}
+
+func formatByteLimit(limit int64) string {
+	if limit <= 0 {
+		return "unlimited"
+	}
+	return formatBytes(uint64(limit))
+}
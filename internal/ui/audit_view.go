@@ -0,0 +1,291 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// maxAuditRows is the number of most recent audit entries shown
+const maxAuditRows = 5000
+
+// AuditView browses the audit log of mutating operations, newest first, with the
+// configuration changes of the selected entry below
+type AuditView struct {
+	ui         *UIManager
+	mainFlex   *tview.Flex
+	table      *tview.Table
+	detailView *tview.TextView
+	entries    []models.AuditEntry // Shown entries, newest first
+	filterText string
+}
+
+// NewAuditView creates a new audit log view
+func NewAuditView(ui *UIManager) *AuditView {
+	view := &AuditView{
+		ui: ui,
+	}
+
+	view.table = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	view.table.SetBorder(true).
+		SetTitle(" Audit Log ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.detailView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+	view.detailView.SetBorder(true).
+		SetTitle(" Entry ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.table, 0, 3, true).
+		AddItem(view.detailView, 0, 2, false)
+
+	view.setupKeybindings()
+	view.setupHeaders()
+
+	return view
+}
+
+func (v *AuditView) setupHeaders() {
+	headers := []string{"TIME", "USER", "CONTEXT", "OPERATION", "TARGET", "RESULT", "DETAILS"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.table.SetCell(0, i, cell)
+	}
+}
+
+func (v *AuditView) setupKeybindings() {
+	v.table.SetSelectionChangedFunc(func(row, column int) {
+		v.showEntry(row)
+	})
+
+	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			// If filter is active, clear it first
+			if v.filterText != "" {
+				v.filterText = ""
+				v.Refresh()
+				return nil
+			}
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyTab:
+			v.ui.app.SetFocus(v.detailView)
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'r':
+				v.Refresh()
+				return nil
+			case '/':
+				v.ui.ShowInputDialog("Filter Audit Log", "Contains:", v.filterText, func(text string) {
+					v.ui.CloseModal()
+					v.filterText = strings.TrimSpace(text)
+					v.Refresh()
+					v.ui.app.SetFocus(v.table)
+				})
+				return nil
+			}
+		}
+		return event
+	})
+
+	v.detailView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyTab || event.Key() == tcell.KeyEsc {
+			v.ui.app.SetFocus(v.table)
+			return nil
+		}
+		return event
+	})
+}
+
+// Refresh re-reads the log, newest entry first
+func (v *AuditView) Refresh() {
+	log := v.ui.client.AuditLog()
+	entries, skipped, err := log.Entries()
+	if err != nil {
+		v.ui.ShowError(err.Error())
+	}
+
+	// Newest first, matching the filter
+	v.entries = v.entries[:0]
+	filter := strings.ToLower(v.filterText)
+	for i := len(entries) - 1; i >= 0 && len(v.entries) < maxAuditRows; i-- {
+		if filter == "" || auditEntryContains(entries[i], filter) {
+			v.entries = append(v.entries, entries[i])
+		}
+	}
+
+	// Clear existing rows (keep header)
+	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+		v.table.RemoveRow(row)
+	}
+
+	for i, entry := range v.entries {
+		row := i + 1
+		resultColor := tcell.ColorGreen
+		if entry.Result != models.AuditResultOK {
+			resultColor = tcell.ColorRed
+		}
+
+		v.table.SetCell(row, 0, tview.NewTableCell(entry.Time.Local().Format("2006-01-02 15:04:05")))
+		v.table.SetCell(row, 1, tview.NewTableCell(entry.User))
+		v.table.SetCell(row, 2, tview.NewTableCell(entry.Context))
+		v.table.SetCell(row, 3, tview.NewTableCell(entry.Operation))
+		v.table.SetCell(row, 4, tview.NewTableCell(entry.Target))
+		v.table.SetCell(row, 5, tview.NewTableCell(entry.Result).SetTextColor(resultColor))
+		v.table.SetCell(row, 6, tview.NewTableCell(entry.Details).SetMaxWidth(50))
+	}
+
+	if len(v.entries) > 0 {
+		v.table.Select(1, 0)
+		v.table.ScrollToBeginning()
+	}
+	v.showEntry(1)
+
+	title := " Audit Log "
+	if v.filterText != "" {
+		title = fmt.Sprintf(" Audit Log - filter: %s ", v.filterText)
+	}
+	v.table.SetTitle(title)
+
+	status := fmt.Sprintf("%d entries, %s", len(v.entries), log.Path())
+	if skipped > 0 {
+		status += fmt.Sprintf(", [red]%d unreadable lines[-]", skipped)
+	}
+	if err := log.Err(); err != nil {
+		status += fmt.Sprintf(", [red]last write failed: %v[-]", err)
+	}
+	v.ui.footer.Update(fmt.Sprintf("r: Refresh  /: Filter  Tab: Scroll entry  Esc: Back  [%s]", status))
+}
+
+// auditEntryContains reports whether any text field of the entry contains filter,
+// which is lower case
+func auditEntryContains(entry models.AuditEntry, filter string) bool {
+	for _, field := range []string{entry.User, entry.Context, entry.Server, entry.Operation, entry.Target, entry.Details, entry.Result, entry.Error} {
+		if strings.Contains(strings.ToLower(field), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// showEntry shows the entry at a table row with its configuration changes
+func (v *AuditView) showEntry(row int) {
+	if row < 1 || row > len(v.entries) {
+		v.detailView.SetText("[gray]No audit entries. Deletes, purges, edits, pauses, step-downs, imports and redrives are recorded here.[white]")
+		return
+	}
+	entry := v.entries[row-1]
+
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("[yellow]Time:[white]      %s\n", entry.Time.Local().Format("2006-01-02 15:04:05 MST")))
+	text.WriteString(fmt.Sprintf("[yellow]User:[white]      %s\n", entry.User))
+	text.WriteString(fmt.Sprintf("[yellow]Context:[white]   %s\n", entry.Context))
+	text.WriteString(fmt.Sprintf("[yellow]Server:[white]    %s\n", entry.Server))
+	text.WriteString(fmt.Sprintf("[yellow]Operation:[white] %s\n", entry.Operation))
+	text.WriteString(fmt.Sprintf("[yellow]Target:[white]    %s\n", entry.Target))
+	if entry.Details != "" {
+		text.WriteString(fmt.Sprintf("[yellow]Details:[white]   %s\n", tview.Escape(entry.Details)))
+	}
+	if entry.Result == models.AuditResultOK {
+		text.WriteString("[yellow]Result:[white]    [green]ok[white]\n")
+	} else {
+		text.WriteString(fmt.Sprintf("[yellow]Result:[white]    [red]%s: %s[white]\n", entry.Result, tview.Escape(entry.Error)))
+	}
+
+	writeAuditConfig(&text, entry.Before, entry.After)
+
+	v.detailView.SetText(text.String())
+	v.detailView.ScrollToBeginning()
+}
+
+// writeAuditConfig writes the settings an operation changed, or the whole
+// configuration if it was only recorded before or after the operation
+func writeAuditConfig(text *strings.Builder, before, after json.RawMessage) {
+	if len(before) == 0 && len(after) == 0 {
+		return
+	}
+
+	var beforeCfg, afterCfg map[string]any
+	json.Unmarshal(before, &beforeCfg)
+	json.Unmarshal(after, &afterCfg)
+
+	if beforeCfg == nil || afterCfg == nil {
+		label, raw := "Configuration before", before
+		if len(before) == 0 {
+			label, raw = "Configuration after", after
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, raw, "", "  "); err != nil {
+			indented.Reset()
+			indented.Write(raw)
+		}
+		text.WriteString(fmt.Sprintf("\n[yellow]%s:[white]\n%s\n", label, tview.Escape(indented.String())))
+		return
+	}
+
+	keys := make(map[string]bool)
+	for key := range beforeCfg {
+		keys[key] = true
+	}
+	for key := range afterCfg {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	text.WriteString("\n[yellow]Configuration changes:[white]\n")
+	changed := false
+	for _, key := range sorted {
+		old, hadOld := beforeCfg[key]
+		updated, hasNew := afterCfg[key]
+		if reflect.DeepEqual(old, updated) {
+			continue
+		}
+		changed = true
+		if hadOld {
+			text.WriteString(fmt.Sprintf("[red]- %s: %s[white]\n", key, tview.Escape(auditValue(old))))
+		}
+		if hasNew {
+			text.WriteString(fmt.Sprintf("[green]+ %s: %s[white]\n", key, tview.Escape(auditValue(updated))))
+		}
+	}
+	if !changed {
+		text.WriteString("[gray]none[white]\n")
+	}
+}
+
+func auditValue(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *AuditView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
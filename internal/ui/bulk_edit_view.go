@@ -0,0 +1,379 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/filter"
+	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/ui/components"
+)
+
+// BulkEditView applies one partial configuration change to the streams matched in
+// the query builder. Streams that failed, or weren't reached, are applied again on
+// the next apply, so a partial failure can be resumed.
+type BulkEditView struct {
+	ui       *UIManager
+	mainFlex *tview.Flex
+	form     *tview.Form
+	diffView *tview.TextView
+	streams  []*models.Stream
+
+	// Patch fields, empty to keep the current setting
+	maxMsgs    string
+	maxBytes   string
+	maxAge     string
+	maxMsgSize string
+	replicas   string
+	retention  string
+	discard    string
+
+	// Outcome per stream of the patch being applied: nil once applied, the error if
+	// it failed. Streams not attempted yet are missing.
+	results  map[string]error
+	gen      int // Incremented when the patch changes, so outcomes of the old one are dropped
+	applying bool
+}
+
+// NewBulkEditView creates a new bulk edit view
+func NewBulkEditView(ui *UIManager) *BulkEditView {
+	view := &BulkEditView{
+		ui:        ui,
+		retention: "keep",
+		discard:   "keep",
+		results:   make(map[string]error),
+	}
+
+	view.buildUI()
+	view.setupKeybindings()
+
+	return view
+}
+
+func (v *BulkEditView) buildUI() {
+	v.form = tview.NewForm()
+	v.form.SetBorder(true).
+		SetTitle(" Bulk Edit - Settings to Change ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Per-stream diff preview and results
+	v.diffView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(false)
+	v.diffView.SetBorder(true).
+		SetTitle(" Changes Preview ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Layout: form on left, diff on right
+	v.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexColumn).
+		AddItem(v.form, 0, 1, true).
+		AddItem(v.diffView, 0, 2, false)
+}
+
+func (v *BulkEditView) setupKeybindings() {
+	v.mainFlex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			v.ui.ShowQueryBuilder()
+			return nil
+		}
+		return event
+	})
+}
+
+// SetStreams sets the streams to edit, starting with an empty patch
+func (v *BulkEditView) SetStreams(streams []*models.Stream) {
+	v.streams = streams
+	v.maxMsgs, v.maxBytes, v.maxAge, v.maxMsgSize, v.replicas = "", "", "", "", ""
+	v.retention, v.discard = "keep", "keep"
+	v.resetResults()
+	v.buildForm()
+	v.diffView.SetText(fmt.Sprintf("[gray]%d streams selected. Enter the settings to change and click 'Preview' to see the diff per stream[white]", len(streams)))
+}
+
+func (v *BulkEditView) buildForm() {
+	v.form.Clear(true)
+
+	// A changed patch has to be applied to every stream again
+	field := func(label, value, placeholder string, target *string) {
+		v.form.AddInputField(label, value, 20, nil, func(text string) {
+			*target = text
+			v.resetResults()
+		})
+		v.form.GetFormItem(v.form.GetFormItemCount() - 1).(*tview.InputField).SetPlaceholder(placeholder)
+	}
+	field("Max Messages", v.maxMsgs, "keep, e.g. 1M", &v.maxMsgs)
+	field("Max Bytes", v.maxBytes, "keep, e.g. 5GB", &v.maxBytes)
+	field("Max Age", v.maxAge, "keep, e.g. 7d", &v.maxAge)
+	field("Max Msg Size", v.maxMsgSize, "keep, e.g. 1MB", &v.maxMsgSize)
+	field("Replicas", v.replicas, "keep, 1-5", &v.replicas)
+
+	retentionOpts := []string{"keep", "limits", "interest", "workqueue"}
+	v.form.AddDropDown("Retention", retentionOpts, optionIndex(retentionOpts, v.retention), func(option string, index int) {
+		if option != v.retention {
+			v.resetResults()
+		}
+		v.retention = option
+	})
+
+	discardOpts := []string{"keep", "old", "new"}
+	v.form.AddDropDown("Discard", discardOpts, optionIndex(discardOpts, v.discard), func(option string, index int) {
+		if option != v.discard {
+			v.resetResults()
+		}
+		v.discard = option
+	})
+
+	// Buttons
+	v.form.AddButton("[ Preview Changes ]", func() {
+		v.previewChanges()
+	})
+
+	v.form.AddButton("[ Apply Changes ]", func() {
+		v.applyChanges()
+	})
+
+	v.form.AddButton("[ Cancel ]", func() {
+		v.ui.ShowQueryBuilder()
+	})
+}
+
+// resetResults forgets which streams the patch was applied to, as it changed
+func (v *BulkEditView) resetResults() {
+	v.results = make(map[string]error)
+	v.gen++
+}
+
+// patch parses the settings in the form. Empty fields are left unchanged, and
+// "unlimited" removes a limit.
+func (v *BulkEditView) patch() (models.StreamPatch, error) {
+	var patch models.StreamPatch
+
+	if text := strings.TrimSpace(v.maxMsgs); text != "" {
+		n := int64(-1)
+		if !strings.EqualFold(text, "unlimited") {
+			count, err := filter.ParseCount(text)
+			if err != nil || count > math.MaxInt64 {
+				return patch, fmt.Errorf("Max Messages: invalid value %q", text)
+			}
+			n = int64(count)
+		}
+		patch.MaxMessages = &n
+	}
+
+	if text := strings.TrimSpace(v.maxBytes); text != "" {
+		n := int64(-1)
+		if !strings.EqualFold(text, "unlimited") {
+			size, err := filter.ParseSize(text)
+			if err != nil || size > math.MaxInt64 {
+				return patch, fmt.Errorf("Max Bytes: invalid value %q", text)
+			}
+			n = int64(size)
+		}
+		patch.MaxBytes = &n
+	}
+
+	if text := strings.TrimSpace(v.maxAge); text != "" {
+		var d time.Duration
+		if !strings.EqualFold(text, "unlimited") {
+			var err error
+			if d, err = filter.ParseDuration(text); err != nil {
+				return patch, fmt.Errorf("Max Age: invalid value %q", text)
+			}
+		}
+		patch.MaxAge = &d
+	}
+
+	if text := strings.TrimSpace(v.maxMsgSize); text != "" {
+		n := int32(-1)
+		if !strings.EqualFold(text, "unlimited") {
+			size, err := filter.ParseSize(text)
+			if err != nil || size > math.MaxInt32 {
+				return patch, fmt.Errorf("Max Msg Size: invalid value %q", text)
+			}
+			n = int32(size)
+		}
+		patch.MaxMsgSize = &n
+	}
+
+	if text := strings.TrimSpace(v.replicas); text != "" {
+		n, err := strconv.Atoi(text)
+		if err != nil || n < 1 || n > 5 {
+			return patch, fmt.Errorf("Replicas: must be 1 to 5, got %q", text)
+		}
+		patch.Replicas = &n
+	}
+
+	if v.retention != "keep" {
+		patch.Retention = v.retention
+	}
+	if v.discard != "keep" {
+		patch.Discard = v.discard
+	}
+
+	return patch, nil
+}
+
+// pending returns the streams the patch changes that it hasn't been applied to yet
+func (v *BulkEditView) pending(patch models.StreamPatch) []string {
+	var names []string
+	for _, stream := range v.streams {
+		if err, attempted := v.results[stream.Name]; attempted && err == nil {
+			continue
+		}
+		if writeStreamDiff(&strings.Builder{}, stream.Config, patch) {
+			names = append(names, stream.Name)
+		}
+	}
+	return names
+}
+
+func (v *BulkEditView) previewChanges() {
+	patch, err := v.patch()
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Invalid settings: %v", err))
+		return
+	}
+	v.renderDiff(patch)
+}
+
+// renderDiff shows each stream's diff, marked with the outcome of applying it
+func (v *BulkEditView) renderDiff(patch models.StreamPatch) {
+	var diff strings.Builder
+	var applied, failed, unchanged int
+
+	for _, stream := range v.streams {
+		var streamDiff strings.Builder
+		changes := writeStreamDiff(&streamDiff, stream.Config, patch)
+
+		err, attempted := v.results[stream.Name]
+		switch {
+		case attempted && err == nil:
+			applied++
+			diff.WriteString(fmt.Sprintf("Stream: [cyan]%s[white]  [green]✓ applied[white]\n\n", stream.Name))
+		case attempted:
+			failed++
+			diff.WriteString(fmt.Sprintf("Stream: [cyan]%s[white]  [red]✗ %v[white]\n\n", stream.Name, err))
+		case !changes:
+			unchanged++
+			diff.WriteString(fmt.Sprintf("Stream: [cyan]%s[white]  [gray]no changes[white]\n\n", stream.Name))
+			continue
+		default:
+			diff.WriteString(fmt.Sprintf("Stream: [cyan]%s[white]\n\n", stream.Name))
+		}
+		diff.WriteString(streamDiff.String())
+	}
+
+	summary := fmt.Sprintf("[yellow]Stream Configuration Changes[white]\n\n%d streams: %d to change, %d unchanged",
+		len(v.streams), len(v.streams)-unchanged-applied, unchanged)
+	if applied > 0 || failed > 0 {
+		summary += fmt.Sprintf(", [green]%d applied[white], [red]%d failed[white]", applied, failed)
+	}
+
+	v.diffView.SetText(summary + "\n\n" + diff.String())
+	v.diffView.ScrollToBeginning()
+}
+
+func (v *BulkEditView) applyChanges() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot edit streams in read-only mode")
+		return
+	}
+	if v.applying {
+		v.ui.ShowError("Changes are still being applied")
+		return
+	}
+
+	patch, err := v.patch()
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Invalid settings: %v", err))
+		return
+	}
+
+	names := v.pending(patch)
+	if len(names) == 0 {
+		v.ui.ShowError("No streams left to change")
+		return
+	}
+	v.renderDiff(patch)
+
+	message := fmt.Sprintf("Apply changes to %d streams?\n\nSee the preview for the changes per stream.", len(names))
+	if len(v.results) > 0 {
+		message = fmt.Sprintf("Resume applying changes to %d streams?\n\nStreams already changed are skipped.", len(names))
+	}
+
+	modal := components.ConfirmModal(
+		message,
+		func() {
+			v.ui.CloseModal()
+			v.performApply(patch, names)
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+
+	v.ui.ShowModal(modal)
+}
+
+// performApply patches the streams one by one in the background, showing each
+// outcome as it arrives
+func (v *BulkEditView) performApply(patch models.StreamPatch, names []string) {
+	v.applying = true
+	gen := v.gen
+	client := v.ui.client
+
+	go func() {
+		failed := 0
+		for _, name := range names {
+			err := client.PatchStream(name, patch)
+			if err != nil {
+				failed++
+			}
+
+			v.ui.app.QueueUpdateDraw(func() {
+				if err == nil {
+					v.ui.cache.invalidate(name)
+				}
+				// The patch may have been edited since, which starts over
+				if gen == v.gen {
+					v.results[name] = err
+					v.renderDiff(patch)
+				}
+			})
+		}
+
+		v.ui.app.QueueUpdateDraw(func() {
+			v.applying = false
+
+			message := fmt.Sprintf("Bulk Edit Complete\n\nUpdated: %d streams\nFailed: %d streams", len(names)-failed, failed)
+			if failed > 0 {
+				message += "\n\nApply again to retry the failed streams."
+			}
+			modal := components.InfoModal("Bulk Edit Result", message, func() {
+				v.ui.CloseModal()
+				v.ui.app.SetFocus(v.form)
+			})
+			v.ui.ShowModal(modal)
+		})
+	}()
+}
+
+// Show shows the bulk edit view
+func (v *BulkEditView) Show() {
+	v.ui.currentPage = "bulk-edit"
+	v.ui.pages.SwitchToPage("bulk-edit")
+	v.ui.app.SetFocus(v.form)
+	v.ui.footer.Update("Tab: Navigate  Enter: Select  Empty fields keep the current setting, 'unlimited' removes a limit  Esc: Back to query builder")
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *BulkEditView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
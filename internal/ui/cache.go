@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// resourceCache holds the streams and consumers fetched by any view, so navigating
+// renders instantly from what is already known and views don't each re-query the
+// server. Views subscribe to be told when entries change; advisories and mutations
+// invalidate entries. It is only used from the UI thread.
+type resourceCache struct {
+	maxAge    time.Duration // Entries younger than this are fresh
+	streams   map[string]*cachedStream
+	consumers map[string]*cachedConsumers // Keyed by stream
+	listedAt  time.Time                   // When all streams were last listed with info
+	listeners []func(stream string)
+}
+
+type cachedStream struct {
+	stream    *models.Stream
+	fetchedAt time.Time
+}
+
+type cachedConsumers struct {
+	consumers []*models.Consumer
+	fetchedAt time.Time
+}
+
+func newResourceCache(maxAge time.Duration) *resourceCache {
+	return &resourceCache{
+		maxAge:    maxAge,
+		streams:   make(map[string]*cachedStream),
+		consumers: make(map[string]*cachedConsumers),
+	}
+}
+
+// subscribe registers a function called with the name of a stream whose entries
+// changed, or "" when several streams changed
+func (c *resourceCache) subscribe(listener func(stream string)) {
+	c.listeners = append(c.listeners, listener)
+}
+
+func (c *resourceCache) notify(stream string) {
+	for _, listener := range c.listeners {
+		listener(stream)
+	}
+}
+
+func (c *resourceCache) fresh(fetchedAt time.Time) bool {
+	return !fetchedAt.IsZero() && time.Since(fetchedAt) < c.maxAge
+}
+
+// stream returns the cached stream and when it was fetched, or nil if it isn't cached
+func (c *resourceCache) stream(name string) (*models.Stream, time.Time) {
+	entry, ok := c.streams[name]
+	if !ok {
+		return nil, time.Time{}
+	}
+	return entry.stream, entry.fetchedAt
+}
+
+// streamFresh reports whether the stream is cached and younger than maxAge
+func (c *resourceCache) streamFresh(name string) bool {
+	_, fetchedAt := c.stream(name)
+	return c.fresh(fetchedAt)
+}
+
+func (c *resourceCache) putStream(stream *models.Stream) {
+	c.streams[stream.Name] = &cachedStream{stream: stream, fetchedAt: time.Now()}
+	c.notify(stream.Name)
+}
+
+// putStreams caches several streams, notifying listeners once
+func (c *resourceCache) putStreams(streams []*models.Stream) {
+	now := time.Now()
+	for _, stream := range streams {
+		c.streams[stream.Name] = &cachedStream{stream: stream, fetchedAt: now}
+	}
+	c.notify("")
+}
+
+// putStreamList caches a complete listing, dropping streams that no longer exist
+func (c *resourceCache) putStreamList(streams []*models.Stream) {
+	listed := make(map[string]bool, len(streams))
+	for _, stream := range streams {
+		listed[stream.Name] = true
+	}
+	c.retainStreams(listed)
+	c.listedAt = time.Now()
+	c.putStreams(streams)
+}
+
+// streamList returns all cached streams if a complete listing is fresh
+func (c *resourceCache) streamList() ([]*models.Stream, bool) {
+	if !c.fresh(c.listedAt) {
+		return nil, false
+	}
+
+	streams := make([]*models.Stream, 0, len(c.streams))
+	for _, entry := range c.streams {
+		streams = append(streams, entry.stream)
+	}
+	return streams, true
+}
+
+// retainStreams drops entries of streams not in names
+func (c *resourceCache) retainStreams(names map[string]bool) {
+	for name := range c.streams {
+		if !names[name] {
+			delete(c.streams, name)
+			delete(c.consumers, name)
+		}
+	}
+}
+
+// consumerList returns the cached consumers of a stream and when they were fetched
+func (c *resourceCache) consumerList(stream string) ([]*models.Consumer, time.Time) {
+	entry, ok := c.consumers[stream]
+	if !ok {
+		return nil, time.Time{}
+	}
+	return entry.consumers, entry.fetchedAt
+}
+
+func (c *resourceCache) putConsumers(stream string, consumers []*models.Consumer) {
+	c.consumers[stream] = &cachedConsumers{consumers: consumers, fetchedAt: time.Now()}
+	c.notify(stream)
+}
+
+// putConsumerLists caches the consumers of several streams, notifying listeners once
+func (c *resourceCache) putConsumerLists(lists map[string][]*models.Consumer) {
+	now := time.Now()
+	for stream, consumers := range lists {
+		c.consumers[stream] = &cachedConsumers{consumers: consumers, fetchedAt: now}
+	}
+	c.notify("")
+}
+
+// consumer returns a cached consumer, or nil if it isn't cached
+func (c *resourceCache) consumer(stream, name string) *models.Consumer {
+	consumers, _ := c.consumerList(stream)
+	for _, consumer := range consumers {
+		if consumer.Name == name {
+			return consumer
+		}
+	}
+	return nil
+}
+
+// putConsumer replaces a single consumer in the stream's cached consumers. It doesn't
+// make the list fresh, as other consumers may have changed.
+func (c *resourceCache) putConsumer(consumer *models.Consumer) {
+	entry, ok := c.consumers[consumer.Stream]
+	if !ok {
+		return
+	}
+
+	for i, cached := range entry.consumers {
+		if cached.Name == consumer.Name {
+			entry.consumers[i] = consumer
+			c.notify(consumer.Stream)
+			return
+		}
+	}
+}
+
+// invalidate drops the entries of a stream so the next refresh fetches them again
+func (c *resourceCache) invalidate(stream string) {
+	delete(c.streams, stream)
+	delete(c.consumers, stream)
+	c.listedAt = time.Time{}
+	c.notify(stream)
+}
+
+// clear drops everything, e.g. after switching contexts
+func (c *resourceCache) clear() {
+	c.streams = make(map[string]*cachedStream)
+	c.consumers = make(map[string]*cachedConsumers)
+	c.listedAt = time.Time{}
+	c.notify("")
+}
+
+// invalidatesCache reports whether an advisory means cached entries of its stream changed
+func invalidatesCache(event *models.Event) bool {
+	if event.Stream == "" {
+		return false
+	}
+
+	switch event.Type {
+	case models.EventStreamCreated, models.EventStreamDeleted, models.EventStreamUpdated,
+		models.EventConsumerCreated, models.EventConsumerDeleted, models.EventConsumerPaused,
+		models.EventStreamLeaderElected, models.EventConsumerLeaderElected:
+		return true
+	}
+	return false
+}
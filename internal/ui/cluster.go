@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// newClusterTable creates the replica table shared by the stream and consumer detail views
+func newClusterTable() *tview.Table {
+	table := tview.NewTable().
+		SetBorders(false).
+		SetSelectable(false, false)
+	table.SetBorder(true).
+		SetTitleAlign(tview.AlignCenter)
+
+	headers := []string{"PEER", "ROLE", "STATE", "LAG", "LAST ACTIVE"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		table.SetCell(0, i, cell)
+	}
+
+	return table
+}
+
+// updateClusterTable renders the RAFT group of a stream or consumer, highlighting
+// offline and lagging replicas. It returns the height the table needs (0 when not clustered).
+func updateClusterTable(table *tview.Table, cluster *models.ClusterInfo) int {
+	// Clear existing rows (keep header)
+	for row := table.GetRowCount() - 1; row > 0; row-- {
+		table.RemoveRow(row)
+	}
+
+	if cluster == nil {
+		return 0
+	}
+
+	leader := cluster.Leader
+	leaderState := "[green]leader"
+	if leader == "" {
+		leader = "(none)"
+		leaderState = "[red]no leader"
+	} else if !cluster.LeaderSince.IsZero() {
+		leaderState = fmt.Sprintf("[green]leader for %s", formatDuration(time.Since(cluster.LeaderSince)))
+	}
+
+	table.SetCell(1, 0, tview.NewTableCell(leader))
+	table.SetCell(1, 1, tview.NewTableCell("leader"))
+	table.SetCell(1, 2, tview.NewTableCell(leaderState))
+	table.SetCell(1, 3, tview.NewTableCell("-"))
+	table.SetCell(1, 4, tview.NewTableCell("-"))
+
+	issues := 0
+	for i, peer := range cluster.Replicas {
+		row := i + 2
+
+		state := "[green]current"
+		switch {
+		case peer.Offline:
+			state = "[red]offline"
+			issues++
+		case !peer.Current:
+			state = "[yellow]lagging"
+			issues++
+		}
+
+		lagColor := tcell.ColorWhite
+		if peer.Lag > 0 {
+			lagColor = tcell.ColorYellow
+		}
+
+		table.SetCell(row, 0, tview.NewTableCell(peer.Name))
+		table.SetCell(row, 1, tview.NewTableCell("replica"))
+		table.SetCell(row, 2, tview.NewTableCell(state))
+		table.SetCell(row, 3, tview.NewTableCell(formatNumber(peer.Lag)).SetTextColor(lagColor))
+		table.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%s ago", peer.Active.Round(time.Millisecond))))
+	}
+
+	title := fmt.Sprintf(" Cluster: %s (R%d) ", cluster.Name, len(cluster.Replicas)+1)
+	if issues > 0 || cluster.Leader == "" {
+		title = fmt.Sprintf(" [red]Cluster: %s (R%d) - %d replica(s) need attention[white] ", cluster.Name, len(cluster.Replicas)+1, issues)
+	}
+	table.SetTitle(title)
+
+	// Header, leader, replicas and borders
+	return len(cluster.Replicas) + 4
+}
@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// columnSpec is a column a configurable table offers
+type columnSpec struct {
+	id     string // Name used in the config
+	header string
+	width  int // Default maximum width, 0 for no limit
+	fit    int // Typical width, used to decide which columns fit the terminal
+}
+
+// tableColumn is a column chosen for a table
+type tableColumn struct {
+	columnSpec
+	maxWidth int // 0 for no limit
+}
+
+// parseColumns resolves configured column names, optionally with a width as in
+// "subjects:40", against the columns a table offers. Without configuration the
+// default columns are used.
+func parseColumns(configured []string, available []columnSpec, defaults []string) ([]tableColumn, error) {
+	if len(configured) == 0 {
+		configured = defaults
+	}
+
+	specs := make(map[string]columnSpec, len(available))
+	for _, spec := range available {
+		specs[spec.id] = spec
+	}
+
+	columns := make([]tableColumn, 0, len(configured))
+	for _, entry := range configured {
+		id, widthText, hasWidth := strings.Cut(strings.TrimSpace(entry), ":")
+		spec, ok := specs[strings.ToLower(id)]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", id, columnIDs(available))
+		}
+
+		column := tableColumn{columnSpec: spec, maxWidth: spec.width}
+		if hasWidth {
+			width, err := strconv.Atoi(widthText)
+			if err != nil || width <= 0 {
+				return nil, fmt.Errorf("invalid width for column %q: %q", id, widthText)
+			}
+			column.maxWidth = width
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// tableColumns returns the columns configured for a view's table. An invalid
+// configuration falls back to the defaults and is reported when the UI starts.
+func (ui *UIManager) tableColumns(view string, available []columnSpec, defaults []string) []tableColumn {
+	columns, err := parseColumns(ui.config.TableColumns(view), available, defaults)
+	if err != nil {
+		ui.configErrors = append(ui.configErrors, fmt.Sprintf("Invalid %s columns in config: %v", view, err))
+		columns, _ = parseColumns(nil, available, defaults)
+	}
+	return columns
+}
+
+// sameColumns reports whether a and b show the same columns in the same order
+func sameColumns(a, b []tableColumn) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].id != b[i].id {
+			return false
+		}
+	}
+	return true
+}
+
+func columnIDs(available []columnSpec) string {
+	ids := make([]string, len(available))
+	for i, spec := range available {
+		ids[i] = spec.id
+	}
+	return strings.Join(ids, ", ")
+}
+
+// fitColumns drops columns from the end until the rest fit in width. The first
+// column is always kept.
+func fitColumns(columns []tableColumn, width int) []tableColumn {
+	if width <= 0 {
+		// Not drawn yet
+		return columns
+	}
+
+	total := 0
+	for i, column := range columns {
+		w := column.fit
+		if column.maxWidth > 0 && column.maxWidth < w {
+			w = column.maxWidth
+		}
+		total += w + 1
+		if total > width && i > 0 {
+			return columns[:i]
+		}
+	}
+	return columns
+}
+
+// columnHeaders returns the headers of columns
+func columnHeaders(columns []tableColumn) []string {
+	headers := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+	}
+	return headers
+}
+
+// setCellText sets the text and maximum width of a table cell, creating the cell if
+// needed. Cells whose text didn't change are left alone.
+func setCellText(table *tview.Table, row, column int, text string, maxWidth int) {
+	if row < table.GetRowCount() && column < table.GetColumnCount() {
+		if cell := table.GetCell(row, column); cell.Text != text || cell.MaxWidth != maxWidth {
+			cell.SetText(text).SetMaxWidth(maxWidth)
+		}
+		return
+	}
+	table.SetCell(row, column, tview.NewTableCell(text).SetMaxWidth(maxWidth))
+}
+
+// trackWidth calls onResize on the UI thread whenever the table's inner width changes
+func trackWidth(ui *UIManager, table *tview.Table, onResize func(width int)) {
+	lastWidth := -1
+	table.SetDrawFunc(func(screen tcell.Screen, x, y, width, height int) (int, int, int, int) {
+		// Inside the border
+		inner := width - 2
+		if inner != lastWidth {
+			lastWidth = inner
+			go ui.app.QueueUpdateDraw(func() { onResize(inner) })
+		}
+		return x + 1, y + 1, width - 2, height - 2
+	})
+}
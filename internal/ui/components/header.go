@@ -2,6 +2,7 @@ package components
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rivo/tview"
 )
@@ -9,6 +10,9 @@ import (
 // Header represents the application header component
 type Header struct {
 	*tview.TextView
+	usage  string // Compact JetStream account usage indicator
+	domain string // Active JetStream domain, if any
+	health string // Connected server, RTT and traffic rates
 }
 
 // NewHeader creates a new header component
@@ -29,10 +33,12 @@ func (h *Header) Update(contextName, status string, readOnly bool) {
 		readOnlyIndicator = " [yellow][READ-ONLY][white]"
 	}
 
-	header := fmt.Sprintf("[yellow]N2S[white] - NATS JetStream TUI          Context: [cyan]%s[white]      %s%s",
+	header := fmt.Sprintf("[yellow]N2S[white] - NATS JetStream TUI          Context: [cyan]%s[white]%s      %s%s%s",
 		contextName,
+		h.domainLabel(),
 		status,
 		readOnlyIndicator,
+		h.usage,
 	)
 	h.SetText(header)
 }
@@ -44,20 +50,96 @@ func (h *Header) UpdateWithSource(contextName, status, configSource string, read
 		readOnlyIndicator = " [yellow][READ-ONLY][white]"
 	}
 
-	header := fmt.Sprintf("[yellow]N2S[white] - NATS JetStream TUI          Context: [cyan]%s[white]      %s%s\n[gray]%s[white]",
+	header := fmt.Sprintf("[yellow]N2S[white] - NATS JetStream TUI          Context: [cyan]%s[white]%s      %s%s%s\n[gray]%s[white]%s",
 		contextName,
+		h.domainLabel(),
 		status,
 		readOnlyIndicator,
+		h.usage,
 		configSource,
+		h.health,
 	)
 	h.SetText(header)
 }
 
-// UpdateStatus updates only the status portion
-func (h *Header) UpdateStatus(connected bool) string {
-	if connected {
-		return "[green]●[white] Connected"
+// UpdateStatus formats the connection state (CONNECTED, RECONNECTING, DISCONNECTED, CLOSED),
+// followed by a recent connection event if there is one
+func (h *Header) UpdateStatus(state, recentEvent string) string {
+	var status string
+	switch state {
+	case "CONNECTED":
+		status = "[green]●[white] Connected"
+	case "RECONNECTING":
+		status = "[yellow]●[white] Reconnecting"
+	case "CLOSED":
+		status = "[red]●[white] Closed"
+	default:
+		status = "[red]●[white] Disconnected"
 	}
-	return "[red]●[white] Disconnected"
+
+	if recentEvent != "" {
+		status += fmt.Sprintf(" [yellow](%s)[white]", recentEvent)
+	}
+	return status
+}
+
+// SetAccountUsage sets the compact account usage bar shown after the status.
+// label names the most used resource (e.g. "file"); a negative percent hides the bar.
+func (h *Header) SetAccountUsage(label string, percent float64) {
+	if percent < 0 {
+		h.usage = ""
+		return
+	}
+
+	barWidth := 10
+	filled := int(percent * float64(barWidth) / 100)
+	if filled > barWidth {
+		filled = barWidth
+	}
+
+	color := "green"
+	if percent > 80 {
+		color = "red"
+	} else if percent > 60 {
+		color = "yellow"
+	}
+
+	h.usage = fmt.Sprintf("      JS %s [%s]%s%s[white] %.0f%%",
+		label,
+		color,
+		strings.Repeat("█", filled),
+		strings.Repeat("░", barWidth-filled),
+		percent,
+	)
 }
 
+// SetDomain sets the JetStream domain shown next to the context ("" hides it)
+func (h *Header) SetDomain(domain string) {
+	h.domain = domain
+}
+
+func (h *Header) domainLabel() string {
+	if h.domain == "" {
+		return ""
+	}
+	return fmt.Sprintf("  Domain: [cyan]%s[white]", h.domain)
+}
+
+// SetHealth sets the connection health shown on the second line: the connected server,
+// round trip time and traffic rates. A non-empty warning highlights the RTT in red.
+func (h *Header) SetHealth(server, rtt, traffic, warning string) {
+	if server == "" {
+		h.health = ""
+		return
+	}
+
+	rttColor := "green"
+	if warning != "" {
+		rttColor = "red"
+	}
+
+	h.health = fmt.Sprintf("      Server: [cyan]%s[white]  RTT: [%s]%s[white]  %s", server, rttColor, rtt, traffic)
+	if warning != "" {
+		h.health += fmt.Sprintf("  [red]⚠ %s[white]", warning)
+	}
+}
@@ -0,0 +1,64 @@
+package components
+
+import (
+	"github.com/rivo/tview"
+)
+
+// ProgressModal shows a live, scrolling log of per-item results for long running operations
+type ProgressModal struct {
+	*tview.Flex
+	status *tview.TextView
+	log    *tview.TextView
+	form   *tview.Form
+}
+
+// NewProgressModal creates a progress dialog; onClose is called when the user dismisses it
+func NewProgressModal(title string, onClose func()) *ProgressModal {
+	p := &ProgressModal{}
+
+	p.status = tview.NewTextView().
+		SetDynamicColors(true)
+
+	p.log = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+
+	p.form = tview.NewForm().
+		AddButton("Close", func() {
+			if onClose != nil {
+				onClose()
+			}
+		})
+
+	content := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(p.status, 1, 0, false).
+		AddItem(p.log, 0, 1, false).
+		AddItem(p.form, 3, 0, true)
+	content.SetBorder(true).
+		SetTitle(" " + title + " ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Center the dialog
+	p.Flex = tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(content, 24, 1, true).
+			AddItem(nil, 0, 1, false), 100, 1, true).
+		AddItem(nil, 0, 1, false)
+
+	return p
+}
+
+// SetStatus updates the summary line
+func (p *ProgressModal) SetStatus(text string) {
+	p.status.SetText(text)
+}
+
+// AddLine appends a result line and keeps the newest line visible
+func (p *ProgressModal) AddLine(line string) {
+	p.log.Write([]byte(line + "\n"))
+	p.log.ScrollToEnd()
+}
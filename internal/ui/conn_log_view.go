@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// ConnLogView lists events of our own NATS connection: disconnects, reconnects,
+// server switches, lame-duck notices, slow consumers and async errors
+type ConnLogView struct {
+	ui    *UIManager
+	table *tview.Table
+}
+
+// NewConnLogView creates a new connection log view
+func NewConnLogView(ui *UIManager) *ConnLogView {
+	view := &ConnLogView{
+		ui: ui,
+	}
+
+	view.table = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	view.table.SetBorder(true).
+		SetTitle(" Connection Log ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.setupKeybindings()
+	view.setupHeaders()
+
+	return view
+}
+
+func (v *ConnLogView) setupHeaders() {
+	headers := []string{"TIME", "EVENT", "SERVER", "DETAIL"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.table.SetCell(0, i, cell)
+	}
+}
+
+func (v *ConnLogView) setupKeybindings() {
+	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'r':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+// Refresh re-renders the log, newest event first
+func (v *ConnLogView) Refresh() {
+	// Clear existing rows (keep header)
+	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+		v.table.RemoveRow(row)
+	}
+
+	events := v.ui.client.ConnectionEvents()
+	for i := range events {
+		event := events[len(events)-1-i]
+		row := i + 1
+
+		v.table.SetCell(row, 0, tview.NewTableCell(event.Time.Format("15:04:05")))
+		v.table.SetCell(row, 1, tview.NewTableCell(event.Type).SetTextColor(connEventColor(event.Type)))
+		v.table.SetCell(row, 2, tview.NewTableCell(event.Server))
+		v.table.SetCell(row, 3, tview.NewTableCell(event.Detail))
+	}
+
+	v.ui.footer.Update(fmt.Sprintf("r: Refresh  Esc: Back  [%d events, status: %s]", len(events), v.ui.client.Status()))
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *ConnLogView) GetPrimitive() tview.Primitive {
+	return v.table
+}
+
+func connEventColor(eventType string) tcell.Color {
+	switch eventType {
+	case models.ConnEventDisconnected, models.ConnEventClosed, models.ConnEventSlowConsumer, models.ConnEventAsyncError:
+		return tcell.ColorRed
+	case models.ConnEventLameDuck:
+		return tcell.ColorYellow
+	case models.ConnEventConnected, models.ConnEventReconnected:
+		return tcell.ColorGreen
+	}
+	return tcell.ColorWhite
+}
@@ -0,0 +1,435 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/filter"
+	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// connectionHeaders are the connection table's column headers
+var connectionHeaders = []string{"NAME", "SERVER", "IP", "LANG", "ACCOUNT", "SUBS", "PENDING", "IN MSGS", "OUT MSGS", "RTT", "LAST ACTIVITY"}
+
+// ConnectionsView lists client connections reported by CONNZ
+type ConnectionsView struct {
+	ui          *UIManager
+	mainFlex    *tview.Flex
+	leftFlex    *tview.Flex
+	table       *tview.Table
+	detailView  *tview.TextView
+	searchInput *tview.InputField
+
+	allConnections []*models.Connection
+	connections    []*models.Connection // Filtered and sorted
+	matches        map[string][]string  // Per connection: why the connection matches the stream scope
+
+	stream         string   // When set, only show connections subscribed to this stream
+	streamSubjects []string // Stream subjects and push consumer deliver subjects
+	filterText     string
+	searching      bool
+	sorter         *tableSorter
+	loading        bool
+}
+
+// NewConnectionsView creates a new connections view
+func NewConnectionsView(ui *UIManager) *ConnectionsView {
+	view := &ConnectionsView{
+		ui:      ui,
+		matches: make(map[string][]string),
+		sorter: newTableSorter(
+			sortField{name: "name", header: "NAME"},
+			sortField{name: "pending", header: "PENDING", desc: true},
+			sortField{name: "in msgs", header: "IN MSGS", desc: true},
+			sortField{name: "out msgs", header: "OUT MSGS", desc: true},
+			sortField{name: "subs", header: "SUBS", desc: true},
+			sortField{name: "rtt", header: "RTT", desc: true},
+			sortField{name: "last activity", header: "LAST ACTIVITY", desc: true},
+		),
+	}
+
+	view.table = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0).
+		SetSelectionChangedFunc(func(row, column int) {
+			view.updateDetail(row)
+		})
+	view.table.SetBorder(true).
+		SetTitleAlign(tview.AlignCenter)
+
+	view.searchInput = tview.NewInputField().
+		SetLabel("Filter: ").
+		SetFieldWidth(50).
+		SetChangedFunc(func(text string) {
+			view.filterText = text
+			view.applyFilter()
+		})
+	view.searchInput.SetBorder(true).
+		SetTitle(" Search (ESC to clear) ").
+		SetTitleAlign(tview.AlignLeft)
+
+	view.detailView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+	view.detailView.SetBorder(true).
+		SetTitle(" Subscriptions ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.leftFlex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.table, 0, 1, true)
+
+	view.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.leftFlex, 0, 2, true).
+		AddItem(view.detailView, 0, 1, false)
+
+	view.setupKeybindings()
+	view.setupHeaders()
+
+	return view
+}
+
+func (v *ConnectionsView) setupHeaders() {
+	v.sorter.setHeaders(v.table, connectionHeaders)
+}
+
+func (v *ConnectionsView) setupKeybindings() {
+	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.setupHeaders()
+			v.applyFilter()
+			return nil
+		}
+
+		switch event.Key() {
+		case tcell.KeyEsc:
+			if v.filterText != "" {
+				v.clearSearch()
+				return nil
+			}
+			if v.stream != "" {
+				v.ui.ShowStreamDetail(v.stream)
+			} else {
+				v.ui.ShowStreamList()
+			}
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case '/':
+				v.showSearch()
+				return nil
+			case 'r':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+
+	v.searchInput.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			v.clearSearch()
+			return nil
+		case tcell.KeyEnter, tcell.KeyTab:
+			v.closeSearch()
+			return nil
+		}
+		return event
+	})
+}
+
+// SetStream scopes the view to connections subscribed to the given stream ("" for all)
+func (v *ConnectionsView) SetStream(stream string) {
+	v.stream = stream
+	v.streamSubjects = nil
+	v.allConnections = nil
+	v.connections = nil
+	v.updateTitle()
+	v.updateTable()
+	v.Refresh()
+}
+
+// Refresh fetches connections in the background and updates the table when done
+func (v *ConnectionsView) Refresh() {
+	if v.loading {
+		return
+	}
+	v.loading = true
+	v.updateFooter()
+
+	client := v.ui.client
+	stream := v.stream
+	go func() {
+		var subjects []string
+		var err error
+		if stream != "" {
+			subjects, err = streamInterestSubjects(client, stream)
+		}
+
+		var connections []*models.Connection
+		if err == nil {
+			connections, err = client.GetConnections(serverPingTimeout)
+		}
+
+		v.ui.app.QueueUpdateDraw(func() {
+			v.loading = false
+			if stream != v.stream {
+				// Scope changed while loading
+				return
+			}
+			if err != nil {
+				v.allConnections = nil
+				v.applyFilter()
+				v.detailView.SetText(fmt.Sprintf("[red]%s[white]\n\n[gray]Use a context with system account credentials to list connections.[white]", tview.Escape(err.Error())))
+				return
+			}
+			v.streamSubjects = subjects
+			v.allConnections = connections
+			v.applyFilter()
+		})
+	}()
+}
+
+// streamInterestSubjects returns the subjects a client must subscribe to in order to
+// receive messages from a stream: its own subjects, the deliver subjects of push consumers
+// and the pull request subject of its consumers under the context's API prefix
+func streamInterestSubjects(client *nats.Client, stream string) ([]string, error) {
+	info, err := client.GetStreamInfo(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream info: %w", err)
+	}
+	consumers, err := client.ListConsumers(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list consumers: %w", err)
+	}
+
+	subjects := append([]string{}, info.Subjects...)
+	for _, consumer := range consumers {
+		if consumer.Config.DeliverSubject != "" {
+			subjects = append(subjects, consumer.Config.DeliverSubject)
+		}
+	}
+	subjects = append(subjects, fmt.Sprintf("%sCONSUMER.MSG.NEXT.%s.>", client.APIPrefix(), stream))
+	return subjects, nil
+}
+
+func (v *ConnectionsView) applyFilter() {
+	// Remember the selected connection across refreshes
+	var selectedKey string
+	if row, _ := v.table.GetSelection(); row > 0 && row <= len(v.connections) {
+		selectedKey = connectionKey(v.connections[row-1])
+	}
+
+	v.matches = make(map[string][]string)
+	v.connections = make([]*models.Connection, 0, len(v.allConnections))
+	for _, conn := range v.allConnections {
+		if v.stream != "" {
+			reasons := v.streamMatches(conn)
+			if len(reasons) == 0 {
+				continue
+			}
+			v.matches[connectionKey(conn)] = reasons
+		}
+		if v.matchesFilter(conn) {
+			v.connections = append(v.connections, conn)
+		}
+	}
+
+	v.sortConnections()
+	v.updateTable()
+
+	row := 1
+	for i, conn := range v.connections {
+		if connectionKey(conn) == selectedKey {
+			row = i + 1
+			break
+		}
+	}
+	if len(v.connections) > 0 {
+		v.table.Select(row, 0)
+	}
+	v.updateDetail(row)
+	v.updateFooter()
+}
+
+// streamMatches returns the subscriptions of conn that receive messages from the scoped stream
+func (v *ConnectionsView) streamMatches(conn *models.Connection) []string {
+	var reasons []string
+	for _, sub := range conn.Subscriptions {
+		for _, subject := range v.streamSubjects {
+			if filter.SubjectsOverlap(sub, subject) {
+				reasons = append(reasons, fmt.Sprintf("%s (matches %s)", sub, subject))
+				break
+			}
+		}
+	}
+	return reasons
+}
+
+func (v *ConnectionsView) matchesFilter(conn *models.Connection) bool {
+	if v.filterText == "" {
+		return true
+	}
+
+	filter := strings.ToLower(v.filterText)
+	fields := []string{conn.Name, conn.Server, conn.IP, conn.Lang, conn.Version, conn.Account, conn.User}
+	fields = append(fields, conn.Subscriptions...)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ConnectionsView) sortConnections() {
+	sortRows(v.sorter, v.connections, func(field string, a, b *models.Connection) bool {
+		switch field {
+		case "pending":
+			return a.PendingBytes < b.PendingBytes
+		case "in msgs":
+			return a.InMsgs < b.InMsgs
+		case "out msgs":
+			return a.OutMsgs < b.OutMsgs
+		case "subs":
+			return a.NumSubs < b.NumSubs
+		case "rtt":
+			return a.RTT < b.RTT
+		case "last activity":
+			return a.LastActivity.Before(b.LastActivity)
+		}
+		return a.Name < b.Name
+	})
+}
+
+func (v *ConnectionsView) updateTable() {
+	// Clear existing rows (keep header)
+	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+		v.table.RemoveRow(row)
+	}
+
+	for i, conn := range v.connections {
+		row := i + 1
+
+		name := conn.Name
+		if name == "" {
+			name = fmt.Sprintf("cid:%d", conn.CID)
+		}
+
+		pendingColor := tcell.ColorWhite
+		if conn.PendingBytes > 0 {
+			pendingColor = tcell.ColorYellow
+		}
+
+		v.table.SetCell(row, 0, tview.NewTableCell(name))
+		v.table.SetCell(row, 1, tview.NewTableCell(conn.Server))
+		v.table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%s:%d", conn.IP, conn.Port)))
+		v.table.SetCell(row, 3, tview.NewTableCell(strings.TrimSpace(conn.Lang+" "+conn.Version)))
+		v.table.SetCell(row, 4, tview.NewTableCell(conn.Account))
+		v.table.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("%d", conn.NumSubs)))
+		v.table.SetCell(row, 6, tview.NewTableCell(formatBytes(uint64(conn.PendingBytes))).SetTextColor(pendingColor))
+		v.table.SetCell(row, 7, tview.NewTableCell(formatNumber(uint64(conn.InMsgs))))
+		v.table.SetCell(row, 8, tview.NewTableCell(formatNumber(uint64(conn.OutMsgs))))
+		v.table.SetCell(row, 9, tview.NewTableCell(conn.RTT.Round(time.Microsecond).String()))
+		v.table.SetCell(row, 10, tview.NewTableCell(formatTime(conn.LastActivity)))
+	}
+}
+
+func (v *ConnectionsView) updateDetail(row int) {
+	if row <= 0 || row > len(v.connections) {
+		v.detailView.SetText("[gray]Select a connection to view its subscriptions[white]")
+		return
+	}
+
+	conn := v.connections[row-1]
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("[yellow]CID:[white] %d  [yellow]Server:[white] %s  [yellow]User:[white] %s  [yellow]Uptime:[white] %s\n",
+		conn.CID, conn.Server, conn.User, conn.Uptime))
+	output.WriteString(fmt.Sprintf("[yellow]In:[white] %s  [yellow]Out:[white] %s\n\n", formatBytes(uint64(conn.InBytes)), formatBytes(uint64(conn.OutBytes))))
+
+	if reasons := v.matches[connectionKey(conn)]; len(reasons) > 0 {
+		output.WriteString(fmt.Sprintf("[green]Receives from %s via:[white]\n", v.stream))
+		for _, reason := range reasons {
+			output.WriteString("  " + tview.Escape(reason) + "\n")
+		}
+		output.WriteString("\n")
+	}
+
+	output.WriteString("[yellow]Subscriptions:[white]\n")
+	if len(conn.Subscriptions) == 0 {
+		output.WriteString("  [gray](none)[white]\n")
+	}
+	for _, sub := range conn.Subscriptions {
+		output.WriteString("  " + tview.Escape(sub) + "\n")
+	}
+
+	v.detailView.SetText(output.String())
+	v.detailView.ScrollToBeginning()
+}
+
+func (v *ConnectionsView) updateTitle() {
+	if v.stream != "" {
+		v.table.SetTitle(fmt.Sprintf(" Connections receiving from %s ", v.stream))
+	} else {
+		v.table.SetTitle(" Connections ")
+	}
+}
+
+func (v *ConnectionsView) updateFooter() {
+	if v.searching {
+		v.ui.footer.Update("Type to filter  Tab/Enter: Jump to list  ESC: Clear filter")
+		return
+	}
+
+	status := fmt.Sprintf("[%d connections, %s]", len(v.connections), v.sorter.status())
+	if v.loading {
+		status = "[yellow]Loading connections...[white]"
+	}
+	v.ui.footer.Update(fmt.Sprintf("/: Filter  o: Sort column  O: Reverse  r: Refresh  Esc: Back  %s", status))
+}
+
+func (v *ConnectionsView) showSearch() {
+	if v.searching {
+		return
+	}
+	v.searching = true
+	v.leftFlex.Clear()
+	v.leftFlex.AddItem(v.searchInput, 3, 0, true)
+	v.leftFlex.AddItem(v.table, 0, 1, false)
+	v.ui.app.SetFocus(v.searchInput)
+	v.updateFooter()
+}
+
+func (v *ConnectionsView) clearSearch() {
+	v.searchInput.SetText("")
+	v.filterText = ""
+	v.closeSearch()
+	v.applyFilter()
+}
+
+func (v *ConnectionsView) closeSearch() {
+	v.searching = false
+	v.leftFlex.Clear()
+	v.leftFlex.AddItem(v.table, 0, 1, true)
+	v.ui.app.SetFocus(v.table)
+	v.updateFooter()
+}
+
+// connectionKey identifies a connection; CIDs are only unique per server
+func connectionKey(conn *models.Connection) string {
+	return fmt.Sprintf("%s/%d", conn.Server, conn.CID)
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *ConnectionsView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
@@ -1,11 +1,14 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"sort"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/shubhamrasal/n2s/internal/ui/components"
 )
 
@@ -15,9 +18,16 @@ type ConsumerDetailView struct {
 	flex         *tview.Flex
 	infoView     *tview.TextView
 	metricsView  *tview.TextView
+	deadTable    *tview.Table
+	clusterTable *tview.Table
 	streamName   string
 	consumerName string
 	consumer     *models.Consumer
+
+	// Dead letters reported by advisories, newest first
+	deadLetters []*models.DeadLetter
+	fetched     map[uint64]*models.Message // Cached message bodies by stream sequence
+	marked      map[uint64]bool
 }
 
 // NewConsumerDetailView creates a new consumer detail view
@@ -42,23 +52,53 @@ func NewConsumerDetailView(ui *UIManager) *ConsumerDetailView {
 		SetTitle(" Metrics (Live) ").
 		SetTitleAlign(tview.AlignCenter)
 
+	// Dead-letter table
+	view.deadTable = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	view.deadTable.SetBorder(true).
+		SetTitle(" Dead Letters (max deliveries / terminated) ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Replica status, hidden when the consumer is not clustered
+	view.clusterTable = newClusterTable()
+
 	// Layout
 	view.flex = tview.NewFlex().
 		SetDirection(tview.FlexRow).
 		AddItem(view.infoView, 6, 0, false).
-		AddItem(view.metricsView, 0, 1, true)
+		AddItem(view.metricsView, 9, 0, false).
+		AddItem(view.clusterTable, 0, 0, false).
+		AddItem(view.deadTable, 0, 1, true)
 
 	view.setupKeybindings()
+	view.setupDeadLetterHeaders()
+	ui.cache.subscribe(view.onCacheChange)
 
 	return view
 }
 
+func (v *ConsumerDetailView) setupDeadLetterHeaders() {
+	headers := []string{"SEQ", "SUBJECT", "DELIVERIES", "REASON", "TIME", "SIZE"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.deadTable.SetCell(0, i, cell)
+	}
+}
+
 func (v *ConsumerDetailView) setupKeybindings() {
 	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEsc:
 			v.ui.ShowStreamDetail(v.streamName)
 			return nil
+		case tcell.KeyEnter:
+			v.viewDeadLetter()
+			return nil
 		case tcell.KeyRune:
 			switch event.Rune() {
 			case 'd':
@@ -67,6 +107,18 @@ func (v *ConsumerDetailView) setupKeybindings() {
 			case 'r':
 				v.Refresh()
 				return nil
+			case ' ':
+				v.toggleMark()
+				return nil
+			case 'R':
+				v.republishDeadLetters()
+				return nil
+			case 'D':
+				v.showCopyToDLQDialog()
+				return nil
+			case 'L':
+				v.stepDownLeader()
+				return nil
 			}
 		}
 		return event
@@ -77,7 +129,14 @@ func (v *ConsumerDetailView) setupKeybindings() {
 func (v *ConsumerDetailView) SetConsumer(streamName, consumerName string) {
 	v.streamName = streamName
 	v.consumerName = consumerName
+	v.deadLetters = nil
+	v.fetched = make(map[uint64]*models.Message)
+	v.marked = make(map[uint64]bool)
 	v.flex.SetTitle(fmt.Sprintf(" Consumer: %s (Stream: %s) ", consumerName, streamName))
+
+	// Show the consumer as last listed by the stream detail view while it is fetched
+	v.consumer = v.ui.cache.consumer(streamName, consumerName)
+	v.renderConsumer()
 	v.Refresh()
 }
 
@@ -87,16 +146,64 @@ func (v *ConsumerDetailView) Refresh() {
 		return
 	}
 
-	// Get consumer info
-	consumer, err := v.ui.client.GetConsumerInfo(v.streamName, v.consumerName)
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to get consumer info: %v", err))
+	// Dead letter messages that haven't been loaded yet are fetched by the worker
+	streamName, consumerName := v.streamName, v.consumerName
+	var missing []uint64
+	for seq := range v.deadLetterAdvisories() {
+		if _, ok := v.fetched[seq]; !ok {
+			missing = append(missing, seq)
+		}
+	}
+
+	v.ui.refresh("consumer-detail", func(client *nats.Client) func() {
+		// Get consumer info
+		consumer, err := client.GetConsumerInfo(streamName, consumerName)
+		if err != nil {
+			return func() { v.ui.ShowError(fmt.Sprintf("Failed to get consumer info: %v", err)) }
+		}
+
+		fetched := make(map[uint64]*models.Message, len(missing))
+		for _, seq := range missing {
+			// A failed fetch is cached as nil: the message was removed from the stream
+			msg, _ := client.GetMessage(streamName, seq)
+			fetched[seq] = msg
+		}
+
+		return func() {
+			v.consumer = consumer
+			v.ui.cache.putConsumer(consumer)
+			for seq, msg := range fetched {
+				v.fetched[seq] = msg
+			}
+
+			v.renderConsumer()
+			v.updateDeadLetters()
+		}
+	})
+}
+
+// onCacheChange shows the consumer when another view fetched a newer version of it
+func (v *ConsumerDetailView) onCacheChange(stream string) {
+	if v.ui.currentPage != "consumer-detail" || (stream != "" && stream != v.streamName) {
+		return
+	}
+
+	if consumer := v.ui.cache.consumer(v.streamName, v.consumerName); consumer != nil && consumer != v.consumer {
+		v.consumer = consumer
+		v.renderConsumer()
+	}
+}
+
+func (v *ConsumerDetailView) renderConsumer() {
+	if v.consumer == nil {
+		v.infoView.SetText("[gray]Loading...[white]")
+		v.metricsView.SetText("")
 		return
 	}
-	v.consumer = consumer
 
 	v.updateInfo()
 	v.updateMetrics()
+	v.flex.ResizeItem(v.clusterTable, updateClusterTable(v.clusterTable, v.consumer.Cluster), 0)
 }
 
 func (v *ConsumerDetailView) updateInfo() {
@@ -145,6 +252,272 @@ func (v *ConsumerDetailView) updateMetrics() {
 	v.metricsView.SetText(metrics)
 }
 
+// deadLetterAdvisories collects max-deliveries and terminated advisories for this consumer
+func (v *ConsumerDetailView) deadLetterAdvisories() map[uint64]*models.DeadLetter {
+	bySeq := make(map[uint64]*models.DeadLetter)
+	for _, event := range v.ui.advisories.snapshot() {
+		if event.Stream != v.streamName || event.Consumer != v.consumerName {
+			continue
+		}
+		if event.Type != models.EventMaxDeliveries && event.Type != models.EventMsgTerminated {
+			continue
+		}
+
+		// Keep the latest advisory per sequence
+		bySeq[event.StreamSeq] = &models.DeadLetter{
+			StreamSeq:  event.StreamSeq,
+			Deliveries: event.Deliveries,
+			Reason:     event.Type,
+			Time:       event.Time,
+		}
+	}
+
+	return bySeq
+}
+
+// updateDeadLetters pairs the dead letter advisories with the messages fetched by Refresh
+func (v *ConsumerDetailView) updateDeadLetters() {
+	bySeq := v.deadLetterAdvisories()
+
+	v.deadLetters = make([]*models.DeadLetter, 0, len(bySeq))
+	for seq, dl := range bySeq {
+		dl.Message = v.fetched[seq]
+		v.deadLetters = append(v.deadLetters, dl)
+	}
+
+	sort.Slice(v.deadLetters, func(i, j int) bool {
+		return v.deadLetters[i].Time.After(v.deadLetters[j].Time)
+	})
+
+	v.updateDeadLetterTable()
+}
+
+func (v *ConsumerDetailView) updateDeadLetterTable() {
+	// Clear existing rows (keep header)
+	for row := v.deadTable.GetRowCount() - 1; row > 0; row-- {
+		v.deadTable.RemoveRow(row)
+	}
+
+	for i, dl := range v.deadLetters {
+		row := i + 1
+
+		seq := fmt.Sprintf("  %d", dl.StreamSeq)
+		if v.marked[dl.StreamSeq] {
+			seq = fmt.Sprintf("[green]* %d[white]", dl.StreamSeq)
+		}
+
+		subject, size := "[gray](removed from stream)[white]", "-"
+		if dl.Message != nil {
+			subject = dl.Message.Subject
+			size = formatBytes(uint64(dl.Message.Size))
+		}
+
+		reason := "max deliveries"
+		if dl.Reason == models.EventMsgTerminated {
+			reason = "terminated"
+		}
+
+		v.deadTable.SetCell(row, 0, tview.NewTableCell(seq))
+		v.deadTable.SetCell(row, 1, tview.NewTableCell(subject))
+		v.deadTable.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%d", dl.Deliveries)))
+		v.deadTable.SetCell(row, 3, tview.NewTableCell(reason).SetTextColor(tcell.ColorRed))
+		v.deadTable.SetCell(row, 4, tview.NewTableCell(formatTime(dl.Time)))
+		v.deadTable.SetCell(row, 5, tview.NewTableCell(size))
+	}
+
+	v.ui.footer.Update(fmt.Sprintf("Enter: View Msg  Space: Mark  R: Republish  D: Copy to DLQ  L: Leader Step-down  d: Delete Consumer  r: Refresh  Esc: Back  [%d dead letters]", len(v.deadLetters)))
+}
+
+func (v *ConsumerDetailView) toggleMark() {
+	row, _ := v.deadTable.GetSelection()
+	if row <= 0 || row > len(v.deadLetters) {
+		return
+	}
+
+	seq := v.deadLetters[row-1].StreamSeq
+	if v.marked[seq] {
+		delete(v.marked, seq)
+	} else {
+		v.marked[seq] = true
+	}
+	v.updateDeadLetterTable()
+
+	if row < len(v.deadLetters) {
+		v.deadTable.Select(row+1, 0)
+	}
+}
+
+func (v *ConsumerDetailView) viewDeadLetter() {
+	row, _ := v.deadTable.GetSelection()
+	if row > 0 && row <= len(v.deadLetters) && v.deadLetters[row-1].Message != nil {
+		v.ui.ShowMessageSequence(v.streamName, v.deadLetters[row-1].StreamSeq)
+	}
+}
+
+// selectedDeadLetters returns the marked sequences, or the selected row if nothing is marked.
+// Messages that no longer exist in the stream are skipped.
+func (v *ConsumerDetailView) selectedDeadLetters() []uint64 {
+	var seqs []uint64
+	if len(v.marked) > 0 {
+		for _, dl := range v.deadLetters {
+			if v.marked[dl.StreamSeq] && dl.Message != nil {
+				seqs = append(seqs, dl.StreamSeq)
+			}
+		}
+	} else if row, _ := v.deadTable.GetSelection(); row > 0 && row <= len(v.deadLetters) && v.deadLetters[row-1].Message != nil {
+		seqs = append(seqs, v.deadLetters[row-1].StreamSeq)
+	}
+
+	sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+	return seqs
+}
+
+// republishDeadLetters publishes the messages again on their original subject
+// so the consumer gets a fresh delivery
+func (v *ConsumerDetailView) republishDeadLetters() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot republish in read-only mode")
+		return
+	}
+
+	seqs := v.selectedDeadLetters()
+	if len(seqs) == 0 {
+		v.ui.ShowError("No dead letters selected (or the messages were removed from the stream)")
+		return
+	}
+
+	opts := nats.RedriveOptions{
+		Target: nats.ImportOptions{
+			Stream:          v.streamName,
+			PreserveHeaders: true,
+		},
+	}
+
+	modal := components.ConfirmModal(
+		fmt.Sprintf("Republish %d dead letters to their original subjects in '%s'?", len(seqs), v.streamName),
+		func() {
+			v.ui.CloseModal()
+			v.runDeadLetterRedrive("Republish Dead Letters", seqs, opts)
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+	v.ui.ShowModal(modal)
+}
+
+func (v *ConsumerDetailView) showCopyToDLQDialog() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot copy messages in read-only mode")
+		return
+	}
+
+	seqs := v.selectedDeadLetters()
+	if len(seqs) == 0 {
+		v.ui.ShowError("No dead letters selected (or the messages were removed from the stream)")
+		return
+	}
+
+	ctx := v.ui.config.CurrentContext()
+	dlqStream := ctx.DLQStream
+	prefix := ctx.DLQSubjectPrefix
+	if prefix == "" {
+		prefix = "dlq"
+	}
+	deleteOriginals := false
+
+	form := tview.NewForm()
+	form.AddTextView("Messages", fmt.Sprintf("%d dead letters", len(seqs)), 0, 1, true, false)
+	form.AddInputField("DLQ Stream", dlqStream, 30, nil, func(text string) {
+		dlqStream = text
+	})
+	form.AddInputField("Subject Prefix", prefix, 30, nil, func(text string) {
+		prefix = text
+	})
+	form.AddCheckbox("Delete Originals", deleteOriginals, func(checked bool) {
+		deleteOriginals = checked
+	})
+	form.AddButton("[ Copy ]", func() {
+		if dlqStream == "" {
+			v.ui.ShowError("DLQ stream cannot be empty")
+			return
+		}
+		v.ui.CloseModal()
+
+		opts := nats.RedriveOptions{
+			Target: nats.ImportOptions{
+				Stream:          dlqStream,
+				SubjectPrefix:   prefix,
+				PreserveHeaders: true,
+				SetMsgID:        true,
+			},
+			DeleteOriginals: deleteOriginals,
+		}
+		v.runDeadLetterRedrive("Copy to DLQ", seqs, opts)
+	})
+	form.AddButton("[ Cancel ]", func() {
+		v.ui.CloseModal()
+	})
+
+	form.SetBorder(true).
+		SetTitle(" Copy Dead Letters to DLQ ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Center the form
+	centered := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(form, 13, 1, true).
+			AddItem(nil, 0, 1, false), 70, 1, true).
+		AddItem(nil, 0, 1, false)
+
+	v.ui.ShowModal(centered)
+}
+
+func (v *ConsumerDetailView) runDeadLetterRedrive(title string, seqs []uint64, opts nats.RedriveOptions) {
+	ctx, cancel := context.WithCancel(context.Background())
+	streamName := v.streamName
+
+	progress := components.NewProgressModal(title, func() {
+		cancel()
+		v.ui.CloseModal()
+		v.marked = make(map[uint64]bool)
+		// Deleted originals must be fetched again
+		v.fetched = make(map[uint64]*models.Message)
+		v.ui.cache.invalidate(v.streamName)
+		v.Refresh()
+	})
+	progress.SetStatus(fmt.Sprintf("[yellow]Publishing 0/%d messages...[white]", len(seqs)))
+	v.ui.ShowModal(progress)
+
+	go func() {
+		done, failed := 0, 0
+		err := v.ui.client.RedriveMessages(ctx, streamName, seqs, opts, func(status nats.RedriveStatus) {
+			done++
+			line := fmt.Sprintf("[green]seq %d -> %s[white]", status.Sequence, status.TargetSubject)
+			if status.Err != nil {
+				failed++
+				line = fmt.Sprintf("[red]seq %d failed: %v[white]", status.Sequence, status.Err)
+			}
+			summary := fmt.Sprintf("[yellow]Publishing %d/%d messages...[white]  Failed: %d", done, len(seqs), failed)
+
+			v.ui.app.QueueUpdateDraw(func() {
+				progress.AddLine(line)
+				progress.SetStatus(summary)
+			})
+		})
+
+		v.ui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				progress.SetStatus(fmt.Sprintf("[red]Interrupted after %d/%d messages: %v[white]", done, len(seqs), err))
+				return
+			}
+			progress.SetStatus(fmt.Sprintf("[green]Done[white]  Published: %d  Failed: %d", done-failed, failed))
+		})
+	}()
+}
+
 func (v *ConsumerDetailView) deleteConsumer() {
 	if v.ui.readOnly {
 		v.ui.ShowError("Cannot delete in read-only mode")
@@ -158,6 +531,7 @@ func (v *ConsumerDetailView) deleteConsumer() {
 			if err := v.ui.client.DeleteConsumer(v.streamName, v.consumerName); err != nil {
 				v.ui.ShowError(fmt.Sprintf("Failed to delete: %v", err))
 			} else {
+				v.ui.cache.invalidate(v.streamName)
 				v.ui.ShowStreamDetail(v.streamName)
 			}
 		},
@@ -169,6 +543,36 @@ func (v *ConsumerDetailView) deleteConsumer() {
 	v.ui.ShowModal(modal)
 }
 
+func (v *ConsumerDetailView) stepDownLeader() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot step down leader in read-only mode")
+		return
+	}
+
+	if v.consumer == nil || v.consumer.Cluster == nil {
+		v.ui.ShowError("Consumer is not clustered")
+		return
+	}
+
+	modal := components.ConfirmModal(
+		fmt.Sprintf("Step down leader '%s' of consumer '%s'?\nA new leader will be elected.", v.consumer.Cluster.Leader, v.consumerName),
+		func() {
+			v.ui.CloseModal()
+			if err := v.ui.client.StepDownConsumerLeader(v.streamName, v.consumerName); err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to step down leader: %v", err))
+			} else {
+				v.ui.cache.invalidate(v.streamName)
+				v.Refresh()
+			}
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+
+	v.ui.ShowModal(modal)
+}
+
 // GetPrimitive returns the primitive for this view
 func (v *ConsumerDetailView) GetPrimitive() tview.Primitive {
 	return v.flex
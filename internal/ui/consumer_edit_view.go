@@ -267,6 +267,7 @@ func (v *ConsumerEditView) performUpdate() {
 		v.showUpdateError(err)
 		return
 	}
+	v.ui.cache.invalidate(v.streamName)
 
 	// Show success and go back
 	modal := components.InfoModal("Consumer Updated",
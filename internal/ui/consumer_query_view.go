@@ -0,0 +1,650 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/filter"
+	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
+	"github.com/shubhamrasal/n2s/internal/ui/components"
+)
+
+// ConsumerQueryView is the query builder's consumer mode: it matches consumers across
+// all streams and deletes, pauses, resumes or edits the matches in bulk
+type ConsumerQueryView struct {
+	ui           *UIManager
+	mainFlex     *tview.Flex
+	form         *tview.Form
+	previewTable *tview.Table
+	statusText   *tview.TextView
+
+	// Filter criteria
+	criteria filter.ConsumerCriteria
+
+	// Bulk edit settings, empty to keep the current setting
+	maxDeliver    string
+	maxAckPending string
+	ackWait       string
+
+	// Preview data
+	matchedConsumers []*models.Consumer
+	sorter           *tableSorter
+
+	running bool // A bulk operation is in progress
+}
+
+// consumerPreviewHeaders are the preview table's column headers
+var consumerPreviewHeaders = []string{"STREAM", "CONSUMER", "TYPE", "PENDING", "ACK PENDING", "REDELIVERED", "LAST DELIVERED", "FILTER"}
+
+// NewConsumerQueryView creates a new consumer query view
+func NewConsumerQueryView(ui *UIManager) *ConsumerQueryView {
+	view := &ConsumerQueryView{
+		ui:       ui,
+		criteria: defaultConsumerCriteria(),
+		sorter: newTableSorter(
+			sortField{name: "stream", header: "STREAM"},
+			sortField{name: "name", header: "CONSUMER"},
+			sortField{name: "pending", header: "PENDING", desc: true},
+			sortField{name: "ack pending", header: "ACK PENDING", desc: true},
+			sortField{name: "redelivered", header: "REDELIVERED", desc: true},
+			sortField{name: "last delivered", header: "LAST DELIVERED"},
+		),
+	}
+
+	view.buildUI()
+	view.setupKeybindings()
+
+	return view
+}
+
+func defaultConsumerCriteria() filter.ConsumerCriteria {
+	return filter.ConsumerCriteria{
+		StreamPattern:   "*",
+		NamePattern:     "*",
+		Type:            "any",
+		PendingOp:       "any",
+		AckPendingOp:    "any",
+		RedeliveredOp:   "any",
+		LastDeliveredOp: "any",
+	}
+}
+
+func (v *ConsumerQueryView) buildUI() {
+	// Create form for filter criteria
+	v.form = tview.NewForm()
+	v.buildFormFields()
+
+	v.form.SetBorder(true).
+		SetTitle(" Bulk Operation - Consumer Criteria ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Preview table
+	v.previewTable = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+
+	v.previewTable.SetBorder(true).
+		SetTitle(" Preview - Click column header or o/O to sort ").
+		SetTitleAlign(tview.AlignCenter)
+
+	v.setupPreviewHeaders()
+
+	// Status text
+	v.statusText = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignCenter)
+	v.statusText.SetBorder(true)
+	v.updateStatus(0)
+
+	// Layout: form on left, preview on right
+	leftFlex := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(v.form, 0, 1, true).
+		AddItem(v.statusText, 3, 0, false)
+
+	v.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexColumn).
+		AddItem(leftFlex, 0, 1, true).
+		AddItem(v.previewTable, 0, 2, false)
+}
+
+func (v *ConsumerQueryView) buildFormFields() {
+	c := &v.criteria
+
+	v.form.AddInputField("Stream Pattern", c.StreamPattern, 30, nil, func(text string) {
+		c.StreamPattern = text
+	})
+	v.form.AddInputField("Name Pattern", c.NamePattern, 30, nil, func(text string) {
+		c.NamePattern = text
+	})
+
+	consumerTypes := []string{"any", "durable", "ephemeral"}
+	v.form.AddDropDown("Type", consumerTypes, optionIndex(consumerTypes, c.Type), func(option string, optionIndex int) {
+		c.Type = option
+	})
+
+	// Messages yet to be delivered, e.g. 10k
+	v.form.AddDropDown("Pending Op", numberOps, optionIndex(numberOps, c.PendingOp), func(option string, optionIndex int) {
+		c.PendingOp = option
+	})
+	v.form.AddInputField("Pending Value", c.Pending, 15, nil, func(text string) {
+		c.Pending = text
+	})
+
+	v.form.AddDropDown("Ack Pending Op", numberOps, optionIndex(numberOps, c.AckPendingOp), func(option string, optionIndex int) {
+		c.AckPendingOp = option
+	})
+	v.form.AddInputField("Ack Pending Value", c.AckPending, 15, nil, func(text string) {
+		c.AckPending = text
+	})
+
+	v.form.AddDropDown("Redelivered Op", numberOps, optionIndex(numberOps, c.RedeliveredOp), func(option string, optionIndex int) {
+		c.RedeliveredOp = option
+	})
+	v.form.AddInputField("Redelivered Value", c.Redelivered, 15, nil, func(text string) {
+		c.Redelivered = text
+	})
+
+	// Time since the last delivery, e.g. 7d. Never delivered counts as longest ago.
+	v.form.AddDropDown("Last Delivered Op", ageOps, optionIndex(ageOps, c.LastDeliveredOp), func(option string, optionIndex int) {
+		c.LastDeliveredOp = option
+	})
+	v.form.AddInputField("Last Delivered", c.LastDelivered, 10, nil, func(text string) {
+		c.LastDelivered = text
+	})
+
+	v.form.AddInputField("Filter Subject", c.Subject, 30, nil, func(text string) {
+		c.Subject = text
+	})
+
+	// Settings changed by Edit All
+	v.form.AddInputField("Set Max Deliver", v.maxDeliver, 10, nil, func(text string) {
+		v.maxDeliver = text
+	})
+	v.form.AddInputField("Set Max Ack Pending", v.maxAckPending, 10, nil, func(text string) {
+		v.maxAckPending = text
+	})
+	v.form.AddInputField("Set Ack Wait", v.ackWait, 10, nil, func(text string) {
+		v.ackWait = text
+	})
+	for _, label := range []string{"Set Max Deliver", "Set Max Ack Pending", "Set Ack Wait"} {
+		v.form.GetFormItemByLabel(label).(*tview.InputField).SetPlaceholder("keep")
+	}
+
+	// Action buttons
+	v.form.AddButton("[ Preview Matches ]", func() {
+		v.previewMatches()
+		v.ui.app.SetFocus(v.form)
+	})
+
+	v.form.AddButton("[ Delete All ]", func() {
+		v.deleteMatched()
+	})
+
+	v.form.AddButton("[ Pause All ]", func() {
+		v.pauseMatched()
+	})
+
+	v.form.AddButton("[ Resume All ]", func() {
+		v.resumeMatched()
+	})
+
+	v.form.AddButton("[ Edit All ]", func() {
+		v.editMatched()
+	})
+
+	v.form.AddButton("[ Clear Filter ]", func() {
+		v.clearFilter()
+	})
+
+	v.form.AddButton("[ Stream Mode ]", func() {
+		v.ui.ShowQueryBuilder()
+	})
+
+	v.form.AddButton("[ Cancel ]", func() {
+		v.ui.ShowStreamList()
+	})
+}
+
+func (v *ConsumerQueryView) setupPreviewHeaders() {
+	for i, header := range consumerPreviewHeaders {
+		cell := tview.NewTableCell(v.sorter.headerLabel(header)).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(true)
+		v.previewTable.SetCell(0, i, cell)
+	}
+}
+
+func (v *ConsumerQueryView) setupKeybindings() {
+	v.mainFlex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			v.ui.ShowStreamList()
+			return nil
+		}
+		return event
+	})
+
+	// Make header clickable for sorting
+	v.previewTable.SetSelectedFunc(func(row, column int) {
+		if row == 0 && v.sorter.sortByHeader(consumerPreviewHeaders[column]) {
+			v.sortPreview()
+		}
+	})
+	v.previewTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.sortPreview()
+			return nil
+		}
+		return event
+	})
+}
+
+func (v *ConsumerQueryView) previewMatches() {
+	matcher, err := v.criteria.Compile()
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	// Get all streams, reusing a fresh listing
+	streams, ok := v.ui.cache.streamList()
+	if ok {
+		v.matchConsumers(matcher, streams)
+		return
+	}
+
+	v.statusText.SetText("[yellow]Listing streams...[white]")
+	v.ui.refresh("consumer-query", func(client *nats.Client) func() {
+		streams, err := client.ListStreams()
+		return func() {
+			if err != nil {
+				v.updateStatus(len(v.matchedConsumers))
+				v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+				return
+			}
+			v.ui.cache.putStreamList(streams)
+			v.matchConsumers(matcher, streams)
+		}
+	})
+}
+
+// matchConsumers shows the consumers matching the filter, listing the consumers of
+// the streams the stream pattern can match in the background first
+func (v *ConsumerQueryView) matchConsumers(matcher *filter.ConsumerMatcher, streams []*models.Stream) {
+	var candidates []*models.Stream
+	for _, stream := range streams {
+		if matcher.MatchStream(stream.Name) {
+			candidates = append(candidates, stream)
+		}
+	}
+
+	names := v.ui.staleConsumerLists(candidates)
+	if len(names) == 0 {
+		v.showMatches(matcher, candidates)
+		return
+	}
+
+	v.statusText.SetText(fmt.Sprintf("[yellow]Listing consumers of %d streams...[white]", len(names)))
+	v.ui.refresh("consumer-query", func(client *nats.Client) func() {
+		consumers, err := client.ListConsumersOf(names)
+		return func() {
+			if err != nil {
+				v.updateStatus(len(v.matchedConsumers))
+				v.ui.ShowError(fmt.Sprintf("Failed to list consumers: %v", err))
+				return
+			}
+			v.ui.cache.putConsumerLists(consumers)
+			v.showMatches(matcher, candidates)
+		}
+	})
+}
+
+func (v *ConsumerQueryView) showMatches(matcher *filter.ConsumerMatcher, candidates []*models.Stream) {
+	v.matchedConsumers = nil
+	for _, stream := range candidates {
+		consumers, _ := v.ui.cache.consumerList(stream.Name)
+		for _, consumer := range consumers {
+			if matcher.Match(consumer) {
+				v.matchedConsumers = append(v.matchedConsumers, consumer)
+			}
+		}
+	}
+
+	// Update preview table in the remembered sort order
+	v.sortPreview()
+	v.updateStatus(len(v.matchedConsumers))
+}
+
+func (v *ConsumerQueryView) updatePreviewTable() {
+	// Clear existing rows
+	for row := v.previewTable.GetRowCount() - 1; row > 0; row-- {
+		v.previewTable.RemoveRow(row)
+	}
+
+	for i, consumer := range v.matchedConsumers {
+		row := i + 1
+		consumerType := "ephemeral"
+		if consumer.Config.Durable != "" {
+			consumerType = "durable"
+		}
+
+		v.previewTable.SetCell(row, 0, tview.NewTableCell(consumer.Stream))
+		v.previewTable.SetCell(row, 1, tview.NewTableCell(consumer.Name))
+		v.previewTable.SetCell(row, 2, tview.NewTableCell(consumerType))
+		v.previewTable.SetCell(row, 3, tview.NewTableCell(formatNumber(consumer.NumPending)))
+		v.previewTable.SetCell(row, 4, tview.NewTableCell(formatNumber(consumer.NumAckPending)))
+		v.previewTable.SetCell(row, 5, tview.NewTableCell(formatNumber(consumer.NumRedelivered)))
+		v.previewTable.SetCell(row, 6, tview.NewTableCell(formatOptionalTime(consumer.Delivered.Last)))
+		v.previewTable.SetCell(row, 7, tview.NewTableCell(consumer.Config.FilterSubject))
+	}
+}
+
+func (v *ConsumerQueryView) sortPreview() {
+	v.setupPreviewHeaders()
+
+	sortRows(v.sorter, v.matchedConsumers, func(field string, a, b *models.Consumer) bool {
+		switch field {
+		case "name":
+			return a.Name < b.Name
+		case "pending":
+			return a.NumPending < b.NumPending
+		case "ack pending":
+			return a.NumAckPending < b.NumAckPending
+		case "redelivered":
+			return a.NumRedelivered < b.NumRedelivered
+		case "last delivered":
+			return a.Delivered.Last.Before(b.Delivered.Last)
+		}
+		if a.Stream != b.Stream {
+			return a.Stream < b.Stream
+		}
+		return a.Name < b.Name
+	})
+
+	v.updatePreviewTable()
+}
+
+func (v *ConsumerQueryView) updateStatus(count int) {
+	if count == 0 {
+		v.statusText.SetText("[gray]Press 'Preview Matches' to see results[white]")
+	} else {
+		v.statusText.SetText(fmt.Sprintf("[yellow]%d consumers match criteria[white]", count))
+	}
+}
+
+// matchedList lists the first matched consumers for confirmations
+func (v *ConsumerQueryView) matchedList() string {
+	names := []string{}
+	for i, c := range v.matchedConsumers {
+		if i < 5 {
+			names = append(names, "  - "+c.Stream+" / "+c.Name)
+		}
+	}
+	if len(v.matchedConsumers) > 5 {
+		names = append(names, fmt.Sprintf("  ... and %d more", len(v.matchedConsumers)-5))
+	}
+	return strings.Join(names, "\n")
+}
+
+// checkMatched reports whether there are matches the operation may change
+func (v *ConsumerQueryView) checkMatched(operation string) bool {
+	if v.running {
+		v.ui.ShowError("A bulk operation is still running")
+		return false
+	}
+
+	if len(v.matchedConsumers) == 0 {
+		v.ui.ShowError("No consumers matched. Press 'Preview Matches' first.")
+		return false
+	}
+
+	if v.ui.readOnly {
+		v.ui.ShowError(fmt.Sprintf("Cannot %s in read-only mode", operation))
+		return false
+	}
+
+	return true
+}
+
+// confirm asks before running an operation on the matched consumers
+func (v *ConsumerQueryView) confirm(message string, perform func()) {
+	modal := components.ConfirmModal(
+		message,
+		func() {
+			v.ui.CloseModal()
+			perform()
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+
+	v.ui.ShowModal(modal)
+}
+
+func (v *ConsumerQueryView) deleteMatched() {
+	if !v.checkMatched("delete") {
+		return
+	}
+
+	message := fmt.Sprintf("Delete %d consumers?\n\n%s\n\nThis action cannot be undone!",
+		len(v.matchedConsumers), v.matchedList())
+
+	v.confirm(message, func() {
+		v.performBulk("Delete", "Deleted", func(client *nats.Client, c *models.Consumer) error {
+			return client.DeleteConsumer(c.Stream, c.Name)
+		})
+	})
+}
+
+func (v *ConsumerQueryView) pauseMatched() {
+	if !v.checkMatched("pause") {
+		return
+	}
+
+	v.ui.ShowInputDialog("Pause Consumers", "Pause for (e.g. 1h, 7d):", "1h", func(text string) {
+		d, err := filter.ParseDuration(strings.TrimSpace(text))
+		if err != nil || d <= 0 {
+			v.ui.ShowError(fmt.Sprintf("Invalid duration %q", text))
+			return
+		}
+		until := time.Now().Add(d)
+
+		message := fmt.Sprintf("Pause %d consumers until %s?\n\n%s\n\nThey deliver no messages until then or until resumed.",
+			len(v.matchedConsumers), until.Format("2006-01-02 15:04:05"), v.matchedList())
+
+		v.confirm(message, func() {
+			v.performBulk("Pause", "Paused", func(client *nats.Client, c *models.Consumer) error {
+				return client.PauseConsumer(c.Stream, c.Name, until)
+			})
+		})
+	})
+}
+
+func (v *ConsumerQueryView) resumeMatched() {
+	if !v.checkMatched("resume") {
+		return
+	}
+
+	message := fmt.Sprintf("Resume %d consumers?\n\n%s", len(v.matchedConsumers), v.matchedList())
+
+	v.confirm(message, func() {
+		v.performBulk("Resume", "Resumed", func(client *nats.Client, c *models.Consumer) error {
+			return client.PauseConsumer(c.Stream, c.Name, time.Time{})
+		})
+	})
+}
+
+func (v *ConsumerQueryView) editMatched() {
+	if !v.checkMatched("edit") {
+		return
+	}
+
+	patch, err := v.patch()
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Invalid settings: %v", err))
+		return
+	}
+
+	var changes []string
+	if patch.MaxDeliver != nil {
+		changes = append(changes, fmt.Sprintf("  Max Deliver: %s", formatConsumerLimit(*patch.MaxDeliver)))
+	}
+	if patch.MaxAckPending != nil {
+		changes = append(changes, fmt.Sprintf("  Max Ack Pending: %s", formatConsumerLimit(*patch.MaxAckPending)))
+	}
+	if patch.AckWait != nil {
+		changes = append(changes, fmt.Sprintf("  Ack Wait: %s", formatDurationToString(*patch.AckWait)))
+	}
+	if len(changes) == 0 {
+		v.ui.ShowError("Enter the settings to change in the 'Set' fields first")
+		return
+	}
+
+	message := fmt.Sprintf("Change %d consumers to\n\n%s\n\n%s",
+		len(v.matchedConsumers), strings.Join(changes, "\n"), v.matchedList())
+
+	v.confirm(message, func() {
+		v.performBulk("Edit", "Updated", func(client *nats.Client, c *models.Consumer) error {
+			return client.PatchConsumer(c.Stream, c.Name, patch)
+		})
+	})
+}
+
+// patch parses the bulk edit settings. Empty fields are left unchanged, and
+// "unlimited" removes a limit.
+func (v *ConsumerQueryView) patch() (models.ConsumerPatch, error) {
+	var patch models.ConsumerPatch
+
+	limit := func(label, text string) (*int, error) {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil, nil
+		}
+		n := -1
+		if !strings.EqualFold(text, "unlimited") {
+			var err error
+			if n, err = strconv.Atoi(text); err != nil || n < 1 || n > math.MaxInt32 {
+				return nil, fmt.Errorf("%s: invalid value %q", label, text)
+			}
+		}
+		return &n, nil
+	}
+
+	var err error
+	if patch.MaxDeliver, err = limit("Max Deliver", v.maxDeliver); err != nil {
+		return patch, err
+	}
+	if patch.MaxAckPending, err = limit("Max Ack Pending", v.maxAckPending); err != nil {
+		return patch, err
+	}
+
+	if text := strings.TrimSpace(v.ackWait); text != "" {
+		d, err := filter.ParseDuration(text)
+		if err != nil || d <= 0 {
+			return patch, fmt.Errorf("Ack Wait: invalid duration %q", text)
+		}
+		patch.AckWait = &d
+	}
+
+	return patch, nil
+}
+
+func formatConsumerLimit(n int) string {
+	if n < 0 {
+		return "unlimited"
+	}
+	return strconv.Itoa(n)
+}
+
+// performBulk runs an operation on every matched consumer in the background, showing
+// the progress in the status line and the outcome when done. Consumers that failed
+// still match afterwards, so running the operation again retries them.
+func (v *ConsumerQueryView) performBulk(operation, done string, perform func(client *nats.Client, c *models.Consumer) error) {
+	v.running = true
+	consumers := v.matchedConsumers
+	client := v.ui.client
+	v.statusText.SetText(fmt.Sprintf("[yellow]%s: 0/%d consumers...[white]", operation, len(consumers)))
+
+	go func() {
+		failCount := 0
+		var failures []string
+
+		for i, consumer := range consumers {
+			err := perform(client, consumer)
+			if err != nil {
+				failCount++
+				if len(failures) < 5 {
+					failures = append(failures, fmt.Sprintf("  - %s / %s: %v", consumer.Stream, consumer.Name, err))
+				}
+			}
+
+			status := fmt.Sprintf("[yellow]%s: %d/%d consumers...[white]  Failed: %d", operation, i+1, len(consumers), failCount)
+			v.ui.app.QueueUpdateDraw(func() {
+				v.ui.cache.invalidate(consumer.Stream)
+				v.statusText.SetText(status)
+			})
+		}
+
+		v.ui.app.QueueUpdateDraw(func() {
+			v.running = false
+
+			message := fmt.Sprintf("Bulk %s Complete\n\n%s: %d consumers\nFailed: %d consumers",
+				operation, done, len(consumers)-failCount, failCount)
+			if len(failures) > 0 {
+				message += "\n\n" + strings.Join(failures, "\n")
+			}
+			if failCount > 0 {
+				message += fmt.Sprintf("\n\n%s again to retry the failed consumers.", operation)
+			}
+
+			// Refresh the preview once the result is dismissed, so a listing error
+			// doesn't hide it
+			modal := components.InfoModal(fmt.Sprintf("Bulk %s Result", operation), message, func() {
+				v.ui.CloseModal()
+				v.ui.app.SetFocus(v.form)
+				v.previewMatches()
+			})
+			v.ui.ShowModal(modal)
+		})
+	}()
+}
+
+func (v *ConsumerQueryView) clearFilter() {
+	v.criteria = defaultConsumerCriteria()
+	v.maxDeliver, v.maxAckPending, v.ackWait = "", "", ""
+	v.matchedConsumers = nil
+
+	// Rebuild form with cleared values
+	v.form.Clear(true)
+	v.buildFormFields()
+
+	// Clear preview
+	for row := v.previewTable.GetRowCount() - 1; row > 0; row-- {
+		v.previewTable.RemoveRow(row)
+	}
+
+	v.updateStatus(0)
+	v.ui.app.SetFocus(v.form)
+}
+
+// Show shows the consumer query view
+func (v *ConsumerQueryView) Show() {
+	v.ui.currentPage = "consumer-query"
+	v.ui.pages.SwitchToPage("consumer-query")
+	v.ui.app.SetFocus(v.form)
+	v.ui.footer.Update("Tab: Navigate fields  Enter: Activate/Open dropdown  Counts: 10k  Durations: 7d  Esc: Cancel")
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *ConsumerQueryView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
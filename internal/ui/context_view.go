@@ -88,7 +88,7 @@ func (v *ContextView) Refresh() {
 		}
 
 		v.table.SetCell(row, 0, tview.NewTableCell(name).SetExpansion(1))
-		v.table.SetCell(row, 1, tview.NewTableCell(ctx.Server).SetExpansion(2))
+		v.table.SetCell(row, 1, tview.NewTableCell(ctx.ServerURLs()).SetExpansion(2))
 	}
 
 	v.ui.footer.Update("↑/↓: Navigate  Enter: Select  q: Quit  ?: Help")
@@ -8,6 +8,7 @@ import (
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
 )
 
 // DescribeView displays stream description and details
@@ -40,6 +41,7 @@ func NewDescribeView(ui *UIManager) *DescribeView {
 		AddItem(view.textView, 0, 1, true)
 
 	view.setupKeybindings()
+	ui.cache.subscribe(view.onCacheChange)
 
 	return view
 }
@@ -53,6 +55,7 @@ func (v *DescribeView) setupKeybindings() {
 		case tcell.KeyRune:
 			switch event.Rune() {
 			case 'r':
+				v.ui.cache.invalidate(v.streamName)
 				v.Refresh()
 				return nil
 			}
@@ -64,32 +67,66 @@ func (v *DescribeView) setupKeybindings() {
 // SetStream sets the stream to describe
 func (v *DescribeView) SetStream(streamName string) {
 	v.streamName = streamName
+	v.stream = nil
 	v.flex.SetTitle(fmt.Sprintf(" Describe: %s ", streamName))
 	v.Refresh()
 }
 
-// Refresh updates the description
+// Refresh shows the cached stream right away and fetches it in the background
+// unless the cached one is fresh
 func (v *DescribeView) Refresh() {
 	if v.streamName == "" {
 		return
 	}
 
-	// Get stream info
-	stream, err := v.ui.client.GetStreamInfo(v.streamName)
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to get stream info: %v", err))
+	v.render()
+
+	streamName := v.streamName
+	if _, consumersAt := v.ui.cache.consumerList(streamName); v.ui.cache.streamFresh(streamName) && v.ui.cache.fresh(consumersAt) {
 		return
 	}
-	v.stream = stream
 
-	// Get consumers
-	consumers, err := v.ui.client.ListConsumers(v.streamName)
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to get consumers: %v", err))
+	v.ui.refresh("describe", func(client *nats.Client) func() {
+		// Get stream info
+		stream, err := client.GetStreamInfo(streamName)
+		if err != nil {
+			return func() { v.ui.ShowError(fmt.Sprintf("Failed to get stream info: %v", err)) }
+		}
+
+		// Get consumers
+		consumers, err := client.ListConsumers(streamName)
+		if err != nil {
+			return func() { v.ui.ShowError(fmt.Sprintf("Failed to get consumers: %v", err)) }
+		}
+
+		// The view is updated through onCacheChange
+		return func() {
+			v.ui.cache.putConsumers(streamName, consumers)
+			v.ui.cache.putStream(stream)
+		}
+	})
+}
+
+// onCacheChange re-renders when the described stream's cached entries change
+func (v *DescribeView) onCacheChange(stream string) {
+	if v.ui.currentPage == "describe" && (stream == "" || stream == v.streamName) {
+		v.render()
+	}
+}
+
+// render shows the cached stream and consumers
+func (v *DescribeView) render() {
+	stream, _ := v.ui.cache.stream(v.streamName)
+	if stream == nil {
+		// Not fetched yet, or invalidated: keep what is shown until it is fetched again
+		if v.stream == nil {
+			v.textView.SetText("[gray]Loading...[white]")
+		}
 		return
 	}
-	v.consumers = consumers
 
+	v.stream = stream
+	v.consumers, _ = v.ui.cache.consumerList(v.streamName)
 	v.updateMetrics()
 }
 
@@ -117,12 +154,12 @@ func (v *DescribeView) updateMetrics() {
 
 	// Visual message count bar
 	output.WriteString("[yellow]Message Count:[white]\n")
-	output.WriteString(v.createBar(v.stream.State.Messages, uint64(v.stream.Config.MaxMessages)))
+	output.WriteString(createBar(v.stream.State.Messages, uint64(v.stream.Config.MaxMessages), formatNumber))
 	output.WriteString("\n\n")
 
 	// Storage usage bar
 	output.WriteString("[yellow]Storage Usage:[white]\n")
-	output.WriteString(v.createBar(v.stream.State.Bytes, uint64(v.stream.Config.MaxBytes)))
+	output.WriteString(createBar(v.stream.State.Bytes, uint64(v.stream.Config.MaxBytes), formatBytes))
 	output.WriteString("\n\n")
 
 	// Limits
@@ -132,6 +169,17 @@ func (v *DescribeView) updateMetrics() {
 	output.WriteString(fmt.Sprintf("[cyan]Max Bytes:[white]       %s\n", formatBytes(uint64(v.stream.Config.MaxBytes))))
 	output.WriteString(fmt.Sprintf("[cyan]Max Msg Size:[white]    %s\n\n", formatBytes(uint64(v.stream.Config.MaxMsgSize))))
 
+	// Mirror and sources
+	if v.stream.Config.Mirror != nil || len(v.stream.Config.Sources) > 0 {
+		output.WriteString("[yellow]═══ REPLICATION ═══[white]\n\n")
+		if v.stream.Config.Mirror != nil {
+			writeStreamSource(&output, "Mirror", v.stream.Config.Mirror)
+		}
+		for _, source := range v.stream.Config.Sources {
+			writeStreamSource(&output, "Source", source)
+		}
+	}
+
 	// Consumer Stats
 	output.WriteString("[yellow]═══ CONSUMER STATISTICS ═══[white]\n\n")
 	output.WriteString(fmt.Sprintf("[cyan]Total Consumers:[white] %d\n\n", len(v.consumers)))
@@ -178,9 +226,10 @@ func (v *DescribeView) updateMetrics() {
 	v.ui.footer.Update("r: Refresh  Esc: Back to Stream")
 }
 
-// createBar creates a visual progress bar
-func (v *DescribeView) createBar(current, max uint64) string {
-	if max == 0 {
+// createBar creates a visual progress bar.
+// A max of 0 (or -1 converted to uint64) means unlimited.
+func createBar(current, max uint64, format func(uint64) string) string {
+	if max == 0 || max > uint64(1<<62) {
 		return "[green][████████████████████████████████████████] unlimited[white]"
 	}
 
@@ -204,12 +253,33 @@ func (v *DescribeView) createBar(current, max uint64) string {
 		strings.Repeat("█", filled),
 		strings.Repeat("░", barWidth-filled),
 		percentage,
-		formatNumber(current),
-		formatNumber(max))
+		format(current),
+		format(max))
 
 	return bar
 }
 
+// writeStreamSource writes the configuration and replication status of a mirror or source
+func writeStreamSource(output *strings.Builder, kind string, source *models.StreamSource) {
+	output.WriteString(fmt.Sprintf("[cyan]%s:[white]          %s\n", kind, source.Name))
+	if source.Domain != "" {
+		output.WriteString(fmt.Sprintf("  Domain:          %s\n", source.Domain))
+	}
+	if source.APIPrefix != "" {
+		output.WriteString(fmt.Sprintf("  API Prefix:      %s\n", source.APIPrefix))
+	}
+	if source.DeliverPrefix != "" {
+		output.WriteString(fmt.Sprintf("  Deliver Prefix:  %s\n", source.DeliverPrefix))
+	}
+	if source.FilterSubject != "" {
+		output.WriteString(fmt.Sprintf("  Filter:          %s\n", source.FilterSubject))
+	}
+	for _, t := range source.SubjectTransforms {
+		output.WriteString(fmt.Sprintf("  Transform:       %s → %s\n", t.Source, t.Destination))
+	}
+	output.WriteString(fmt.Sprintf("  Status:          %s\n\n", sourceStatus(source)))
+}
+
 // GetPrimitive returns the primitive for this view
 func (v *DescribeView) GetPrimitive() tview.Primitive {
 	return v.flex
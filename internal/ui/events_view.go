@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// maxEvents is the number of advisories kept in memory
+const maxEvents = 1000
+
+// advisoryLog keeps the most recent advisories received from the server.
+// Events are added from the NATS subscription goroutine, so access is locked.
+type advisoryLog struct {
+	mu     sync.Mutex
+	events []*models.Event
+}
+
+func (l *advisoryLog) add(event *models.Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.events = append(l.events, event)
+	if len(l.events) > maxEvents {
+		l.events = l.events[len(l.events)-maxEvents:]
+	}
+}
+
+// snapshot returns a copy of the events, oldest first
+func (l *advisoryLog) snapshot() []*models.Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	events := make([]*models.Event, len(l.events))
+	copy(events, l.events)
+	return events
+}
+
+func (l *advisoryLog) clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = nil
+}
+
+// EventsView displays a timeline of JetStream advisories
+type EventsView struct {
+	ui           *UIManager
+	mainFlex     *tview.Flex
+	filterForm   *tview.Form
+	table        *tview.Table
+	detailView   *tview.TextView
+	events       []*models.Event // Filtered events, newest first
+	streamText   string
+	consumerText string
+	typeText     string
+}
+
+// NewEventsView creates a new events view
+func NewEventsView(ui *UIManager) *EventsView {
+	view := &EventsView{
+		ui: ui,
+	}
+
+	// Filter bar
+	view.filterForm = tview.NewForm().
+		SetHorizontal(true).
+		AddInputField("Stream", "", 20, nil, func(text string) {
+			view.streamText = text
+			view.Refresh()
+		}).
+		AddInputField("Consumer", "", 20, nil, func(text string) {
+			view.consumerText = text
+			view.Refresh()
+		}).
+		AddInputField("Type", "", 20, nil, func(text string) {
+			view.typeText = text
+			view.Refresh()
+		})
+	view.filterForm.SetBorder(true).
+		SetTitle(" Filter (/ to edit, Esc to return) ").
+		SetTitleAlign(tview.AlignLeft)
+
+	// Event timeline
+	view.table = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0).
+		SetSelectionChangedFunc(func(row, column int) {
+			view.updateDetail(row)
+		})
+	view.table.SetBorder(true).
+		SetTitle(" JetStream Events ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Raw advisory panel
+	view.detailView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+	view.detailView.SetBorder(true).
+		SetTitle(" Advisory ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.filterForm, 3, 0, false).
+		AddItem(view.table, 0, 2, true).
+		AddItem(view.detailView, 0, 1, false)
+
+	view.setupKeybindings()
+	view.setupHeaders()
+
+	return view
+}
+
+func (v *EventsView) setupHeaders() {
+	headers := []string{"TIME", "TYPE", "STREAM", "CONSUMER", "DETAIL"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.table.SetCell(0, i, cell)
+	}
+}
+
+func (v *EventsView) setupKeybindings() {
+	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			v.jumpToEvent()
+			return nil
+		case tcell.KeyEsc:
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case '/':
+				v.ui.app.SetFocus(v.filterForm)
+				return nil
+			case 's':
+				v.jumpToStream()
+				return nil
+			case 'r':
+				v.Refresh()
+				return nil
+			case 'X':
+				v.ui.advisories.clear()
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+
+	v.filterForm.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEsc {
+			v.ui.app.SetFocus(v.table)
+			return nil
+		}
+		return event
+	})
+}
+
+// Refresh re-renders the timeline from the advisory log
+func (v *EventsView) Refresh() {
+	// Remember the selected event so new arrivals don't move the cursor
+	var selectedID string
+	if row, _ := v.table.GetSelection(); row > 0 && row <= len(v.events) {
+		selectedID = v.events[row-1].ID
+	}
+
+	all := v.ui.advisories.snapshot()
+	v.events = make([]*models.Event, 0, len(all))
+	for i := len(all) - 1; i >= 0; i-- {
+		if v.matchesFilter(all[i]) {
+			v.events = append(v.events, all[i])
+		}
+	}
+
+	v.updateTable()
+
+	if selectedID != "" {
+		for i, e := range v.events {
+			if e.ID == selectedID {
+				v.table.Select(i+1, 0)
+				break
+			}
+		}
+	}
+}
+
+func (v *EventsView) matchesFilter(event *models.Event) bool {
+	contains := func(value, filter string) bool {
+		return filter == "" || strings.Contains(strings.ToLower(value), strings.ToLower(filter))
+	}
+
+	return contains(event.Stream, v.streamText) &&
+		contains(event.Consumer, v.consumerText) &&
+		contains(event.Type, v.typeText)
+}
+
+func (v *EventsView) updateTable() {
+	// Clear existing rows (keep header)
+	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+		v.table.RemoveRow(row)
+	}
+
+	for i, event := range v.events {
+		row := i + 1
+
+		v.table.SetCell(row, 0, tview.NewTableCell(event.Time.Local().Format("15:04:05")))
+		v.table.SetCell(row, 1, tview.NewTableCell(event.Type).SetTextColor(eventColor(event.Type)))
+		v.table.SetCell(row, 2, tview.NewTableCell(event.Stream))
+		v.table.SetCell(row, 3, tview.NewTableCell(event.Consumer))
+		v.table.SetCell(row, 4, tview.NewTableCell(event.Description))
+	}
+
+	v.ui.footer.Update(fmt.Sprintf("Enter: Jump  s: Stream  /: Filter  X: Clear  r: Refresh  Esc: Back  [%d events]", len(v.events)))
+}
+
+func (v *EventsView) updateDetail(row int) {
+	if row <= 0 || row > len(v.events) {
+		v.detailView.SetText("[gray]Select an event to view the advisory[white]")
+		return
+	}
+
+	event := v.events[row-1]
+	payload := string(event.Raw)
+	var pretty interface{}
+	if json.Unmarshal(event.Raw, &pretty) == nil {
+		if formatted, err := json.MarshalIndent(pretty, "", "  "); err == nil {
+			payload = string(formatted)
+		}
+	}
+
+	v.detailView.SetText(fmt.Sprintf("[yellow]Subject:[white] %s\n\n%s", event.Subject, tview.Escape(payload)))
+	v.detailView.ScrollToBeginning()
+}
+
+// jumpToEvent opens the most specific resource the event refers to
+func (v *EventsView) jumpToEvent() {
+	row, _ := v.table.GetSelection()
+	if row <= 0 || row > len(v.events) {
+		return
+	}
+
+	event := v.events[row-1]
+	switch {
+	case event.Stream != "" && event.StreamSeq > 0:
+		v.ui.ShowMessageSequence(event.Stream, event.StreamSeq)
+	case event.Stream != "" && event.Consumer != "" && event.Type != models.EventConsumerDeleted:
+		v.ui.ShowConsumerDetail(event.Stream, event.Consumer)
+	case event.Stream != "" && event.Type != models.EventStreamDeleted:
+		v.ui.ShowStreamDetail(event.Stream)
+	}
+}
+
+func (v *EventsView) jumpToStream() {
+	row, _ := v.table.GetSelection()
+	if row > 0 && row <= len(v.events) && v.events[row-1].Stream != "" {
+		v.ui.ShowStreamDetail(v.events[row-1].Stream)
+	}
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *EventsView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
+
+// eventColor highlights advisories that usually need attention
+func eventColor(eventType string) tcell.Color {
+	switch eventType {
+	case models.EventMaxDeliveries, models.EventMsgTerminated, models.EventStreamQuorumLost, models.EventConsumerQuorumLost:
+		return tcell.ColorRed
+	case models.EventStreamDeleted, models.EventConsumerDeleted, models.EventMsgNaked:
+		return tcell.ColorYellow
+	case models.EventStreamLeaderElected, models.EventConsumerLeaderElected:
+		return tcell.ColorAqua
+	}
+	return tcell.ColorWhite
+}
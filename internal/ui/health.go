@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// healthProbeInterval is how often the connection health is measured
+const healthProbeInterval = 2 * time.Second
+
+// healthLoop probes the connection in the background and shows the result in the header
+func (ui *UIManager) healthLoop() {
+	probe := &nats.HealthProbe{}
+	probedGen := 0
+	ticker := time.NewTicker(healthProbeInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		// Rates and the RTT average don't carry over to another context's connection
+		client, gen := ui.sharedClient()
+		if gen != probedGen {
+			probe.Reset()
+			probedGen = gen
+		}
+		health := probe.Probe(client)
+
+		ui.app.QueueUpdateDraw(func() {
+			// The probed client may have been closed by a context switch meanwhile
+			if client != ui.client {
+				return
+			}
+			traffic := fmt.Sprintf("In: %.0f msg/s %s/s  Out: %.0f msg/s %s/s",
+				health.InMsgs, formatBytes(uint64(health.InBytes)),
+				health.OutMsgs, formatBytes(uint64(health.OutBytes)))
+			ui.header.SetHealth(health.Server, health.RTT.Round(time.Microsecond).String(), traffic, health.Warning)
+			ui.updateHeader()
+		})
+	}
+}
@@ -47,11 +47,21 @@ func (v *HelpView) getHelpText() string {
 [yellow]Stream List View[white]
   ↑/↓, j/k   Navigate streams
   Enter      View stream details
-  /          Filter streams
+  /          Filter streams by name or expression, e.g.
+             msgs>1e6 AND storage=memory, age>7d OR NOT consumers=0
+             (subject=<subject> filters on the server)
   d          Describe Stream
   x          Delete stream (with confirmation)
   p          Purge stream messages (with confirmation)
   m          View messages
+  E          JetStream events timeline
+  a          JetStream account usage
+  S          Servers (system account)
+  C          Client connections (system account)
+  T          Replication topology
+  l          Connection event log
+  A          Audit log of changes made with n2s
+  o / O      Cycle sort column / reverse order
   r          Refresh
   Esc        Back to context selection
 
@@ -60,9 +70,45 @@ func (v *HelpView) getHelpText() string {
   Enter      View consumer details
   d          Describe Stream
   m          View messages in stream
+  C          Connections receiving from this stream
+  L          Step down stream leader
   x          Delete selected consumer
+  o / O      Cycle sort column / reverse order
   Esc        Back to stream list
 
+[yellow]Events View[white]
+  Enter      Jump to related message/consumer/stream
+  s          Jump to stream
+  /          Filter by stream, consumer or type
+  X          Clear timeline
+  Esc        Back to stream list
+
+[yellow]Account View (a)[white]
+  r          Refresh
+  Esc        Back to stream list
+
+[yellow]Servers View (S)[white]
+  Enter      Raw JSON full screen
+  V/J/H      Show VARZ / JSZ / HEALTHZ
+  r          Refresh
+  Esc        Back to stream list
+
+[yellow]Connections View (C)[white]
+  /          Filter connections
+  o / O      Cycle sort column / reverse order
+  r          Refresh
+  Esc        Clear filter or go back
+
+[yellow]Topology View (T)[white]
+  r          Refresh
+  Esc        Back to stream list
+
+[yellow]Audit Log (A)[white]
+  /          Filter entries
+  Tab        Scroll the selected entry
+  r          Refresh
+  Esc        Clear filter / Back to stream list
+
 [yellow]Describe View[white]
   r          Refresh
   Esc        Back to stream detail
@@ -72,12 +118,21 @@ func (v *HelpView) getHelpText() string {
   Esc        Back to stream list
 
 [yellow]Consumer Detail View[white]
+  Enter      View dead-lettered message
+  Space      Mark/unmark dead letter
+  R          Republish dead letters
+  D          Copy dead letters to DLQ stream
+  L          Step down consumer leader
   d          Delete consumer (with confirmation)
   Esc        Back to stream detail
 
 [yellow]Message Browser View[white]
   ↑/↓, j/k   Navigate messages
   Enter      View message detail
+  Space      Mark/unmark message for redrive
+  R          Redrive marked messages or a range
+  i          Import messages from a JSONL file
+  o / O      Cycle sort column / reverse order
   Esc        Back
 
 [yellow]Tips[white]
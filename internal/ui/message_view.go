@@ -1,12 +1,19 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
+	"github.com/shubhamrasal/n2s/internal/ui/components"
 )
 
 // MessageView displays messages from a stream
@@ -17,11 +24,15 @@ type MessageView struct {
 	detailView    *tview.TextView
 	streamName    string
 	messages      []*models.Message
+	rows          []*models.Message // Messages in table order
+	sorter        *tableSorter
 	selectedMsg   *models.MessageDetail
 	loading       bool
 	loadingStart  time.Time
 	focusOnDetail bool
 	stopLoading   chan bool
+	marked        map[uint64]bool // Sequences selected for redrive
+	pendingSeq    uint64          // Sequence to show once the current load finishes
 }
 
 // NewMessageView creates a new message view
@@ -29,6 +40,14 @@ func NewMessageView(ui *UIManager) *MessageView {
 	view := &MessageView{
 		ui:       ui,
 		messages: make([]*models.Message, 0),
+		marked:   make(map[uint64]bool),
+		// Newest first by default
+		sorter: newTableSorter(
+			sortField{name: "seq", header: "SEQ", desc: true},
+			sortField{name: "subject", header: "SUBJECT"},
+			sortField{name: "time", header: "TIME", desc: true},
+			sortField{name: "size", header: "SIZE", desc: true},
+		),
 	}
 
 	// Message table
@@ -62,18 +81,17 @@ func NewMessageView(ui *UIManager) *MessageView {
 }
 
 func (view *MessageView) setupHeaders() {
-	headers := []string{"SEQ", "SUBJECT", "TIME", "SIZE"}
-	for i, header := range headers {
-		cell := tview.NewTableCell(header).
-			SetTextColor(tcell.ColorYellow).
-			SetAlign(tview.AlignLeft).
-			SetSelectable(false)
-		view.messageTable.SetCell(0, i, cell)
-	}
+	view.sorter.setHeaders(view.messageTable, []string{"SEQ", "SUBJECT", "TIME", "SIZE"})
 }
 
 func (v *MessageView) setupKeybindings() {
 	v.messageTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.setupHeaders()
+			v.updateTable()
+			return nil
+		}
+
 		switch event.Key() {
 		case tcell.KeyEnter:
 			v.onEnter()
@@ -89,6 +107,15 @@ func (v *MessageView) setupKeybindings() {
 			case 'r':
 				v.Refresh()
 				return nil
+			case 'i':
+				v.showImportDialog()
+				return nil
+			case ' ':
+				v.toggleMark()
+				return nil
+			case 'R':
+				v.showRedriveDialog()
+				return nil
 			}
 		}
 		return event
@@ -117,6 +144,7 @@ func (v *MessageView) clearAndGoBack() {
 	// Clear message detail
 	v.selectedMsg = nil
 	v.messages = []*models.Message{}
+	v.rows = nil
 	v.detailView.Clear()
 	v.detailView.SetText("[gray]Select a message to view details[white]")
 	
@@ -133,8 +161,9 @@ func (v *MessageView) SetStream(streamName string) {
 	v.streamName = streamName
 	v.flex.SetTitle(fmt.Sprintf(" Messages: %s ", streamName))
 	
-	// Clear old message detail when switching streams
+	// Clear old message detail and marks when switching streams
 	v.selectedMsg = nil
+	v.marked = make(map[uint64]bool)
 	v.detailView.Clear()
 	v.detailView.SetText("[gray]Select a message to view details[white]")
 	
@@ -175,10 +204,44 @@ func (v *MessageView) Refresh() {
 
 			v.messages = messages
 			v.updateTable()
+
+			if v.pendingSeq != 0 {
+				seq := v.pendingSeq
+				v.pendingSeq = 0
+				v.showSequence(seq)
+			}
 		})
 	}()
 }
 
+// ShowSequence selects a message by sequence once messages are loaded.
+// Messages outside the loaded window are still shown in the detail pane.
+func (v *MessageView) ShowSequence(seq uint64) {
+	if v.loading {
+		v.pendingSeq = seq
+		return
+	}
+	v.showSequence(seq)
+}
+
+func (v *MessageView) showSequence(seq uint64) {
+	for i, msg := range v.rows {
+		if msg.Sequence == seq {
+			v.messageTable.Select(i+1, 0)
+			break
+		}
+	}
+
+	detail, err := v.ui.client.GetMessageDetail(v.streamName, seq)
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Failed to get message %d: %v", seq, err))
+		return
+	}
+
+	v.selectedMsg = detail
+	v.updateDetail()
+}
+
 func (v *MessageView) stopLoadingAnimation() {
 	v.loading = false
 	if v.stopLoading != nil {
@@ -213,7 +276,7 @@ func (v *MessageView) switchFocus() {
 		v.messageTable.SetBorderColor(tcell.ColorGreen)
 		v.detailView.SetBorderColor(tcell.ColorGray)
 		v.ui.app.SetFocus(v.messageTable)
-		v.ui.footer.Update(fmt.Sprintf("Enter: View Detail  Tab: Switch pane  r: Refresh  Esc: Back  [Showing last %d messages]", len(v.messages)))
+		v.ui.footer.Update(fmt.Sprintf("Enter: View Detail  Tab: Switch pane  Space: Mark  R: Redrive  i: Import  o/O: Sort  r: Refresh  Esc: Back  [Showing last %d messages, %s]", len(v.messages), v.sorter.status()))
 	}
 }
 
@@ -228,10 +291,22 @@ func (v *MessageView) updateTable() {
 	v.detailView.Clear()
 	v.detailView.SetText("[gray]Select a message to view details[white]")
 
-	// Add message rows (reverse order - newest first)
-	for i := len(v.messages) - 1; i >= 0; i-- {
-		msg := v.messages[i]
-		row := len(v.messages) - i
+	v.rows = append([]*models.Message(nil), v.messages...)
+	sortRows(v.sorter, v.rows, func(field string, a, b *models.Message) bool {
+		switch field {
+		case "subject":
+			return a.Subject < b.Subject
+		case "time":
+			return a.Timestamp.Before(b.Timestamp)
+		case "size":
+			return a.Size < b.Size
+		}
+		return a.Sequence < b.Sequence
+	})
+
+	// Add message rows in sort order
+	for i, msg := range v.rows {
+		row := i + 1
 		
 		// Format timestamp
 		timeStr := formatTime(msg.Timestamp)
@@ -242,7 +317,12 @@ func (v *MessageView) updateTable() {
 			subject = subject[:37] + "..."
 		}
 		
-		v.messageTable.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("%d", msg.Sequence)))
+		seq := fmt.Sprintf("  %d", msg.Sequence)
+		if v.marked[msg.Sequence] {
+			seq = fmt.Sprintf("[green]* %d[white]", msg.Sequence)
+		}
+
+		v.messageTable.SetCell(row, 0, tview.NewTableCell(seq))
 		v.messageTable.SetCell(row, 1, tview.NewTableCell(subject))
 		v.messageTable.SetCell(row, 2, tview.NewTableCell(timeStr))
 		v.messageTable.SetCell(row, 3, tview.NewTableCell(formatBytes(uint64(msg.Size))))
@@ -252,15 +332,13 @@ func (v *MessageView) updateTable() {
 	v.focusOnDetail = false
 	v.messageTable.SetBorderColor(tcell.ColorGreen)
 	v.detailView.SetBorderColor(tcell.ColorGray)
-	v.ui.footer.Update(fmt.Sprintf("Enter: View Detail  Tab: Switch pane  r: Refresh  Esc: Back  [Showing last %d messages]", len(v.messages)))
+	v.ui.footer.Update(fmt.Sprintf("Enter: View Detail  Tab: Switch pane  Space: Mark  R: Redrive  i: Import  o/O: Sort  r: Refresh  Esc: Back  [Showing last %d messages, %s]", len(v.messages), v.sorter.status()))
 }
 
 func (v *MessageView) onEnter() {
 	row, _ := v.messageTable.GetSelection()
-	if row > 0 && row <= len(v.messages) {
-		// Messages are in reverse order in the table
-		idx := len(v.messages) - row
-		msg := v.messages[idx]
+	if row > 0 && row <= len(v.rows) {
+		msg := v.rows[row-1]
 		
 		// Get full message detail
 		detail, err := v.ui.client.GetMessageDetail(v.streamName, msg.Sequence)
@@ -310,6 +388,366 @@ func (v *MessageView) updateDetail() {
 	v.detailView.SetText(detail)
 }
 
+func (v *MessageView) toggleMark() {
+	row, _ := v.messageTable.GetSelection()
+	if row <= 0 || row > len(v.rows) {
+		return
+	}
+
+	msg := v.rows[row-1]
+	if v.marked[msg.Sequence] {
+		delete(v.marked, msg.Sequence)
+		v.messageTable.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("  %d", msg.Sequence)))
+	} else {
+		v.marked[msg.Sequence] = true
+		v.messageTable.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("[green]* %d[white]", msg.Sequence)))
+	}
+
+	// Move down so several messages can be marked quickly
+	if row < len(v.rows) {
+		v.messageTable.Select(row+1, 0)
+	}
+}
+
+func (v *MessageView) showRedriveDialog() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot redrive messages in read-only mode")
+		return
+	}
+
+	startSeq, endSeq, filter := "", "", ""
+	opts := nats.RedriveOptions{
+		Target: nats.ImportOptions{
+			PreserveHeaders: true,
+			SetMsgID:        true,
+		},
+	}
+
+	form := tview.NewForm()
+	if len(v.marked) > 0 {
+		form.AddTextView("Messages", fmt.Sprintf("%d marked messages", len(v.marked)), 0, 1, true, false)
+	} else {
+		form.AddInputField("Start Seq", startSeq, 15, tview.InputFieldInteger, func(text string) {
+			startSeq = text
+		})
+		form.AddInputField("End Seq (blank = last)", endSeq, 15, tview.InputFieldInteger, func(text string) {
+			endSeq = text
+		})
+		form.AddInputField("Subject Filter", filter, 30, nil, func(text string) {
+			filter = text
+		})
+	}
+	form.AddInputField("Target Stream", "", 30, nil, func(text string) {
+		opts.Target.Stream = text
+	})
+	form.AddInputField("Target Subject", "", 30, nil, func(text string) {
+		opts.Target.Subject = text
+	})
+	form.AddInputField("Rewrite From", "", 30, nil, func(text string) {
+		opts.Target.RewriteFrom = text
+	})
+	form.AddInputField("Rewrite To", "", 30, nil, func(text string) {
+		opts.Target.RewriteTo = text
+	})
+	form.AddCheckbox("Set Nats-Msg-Id", opts.Target.SetMsgID, func(checked bool) {
+		opts.Target.SetMsgID = checked
+	})
+	form.AddCheckbox("Delete Originals", opts.DeleteOriginals, func(checked bool) {
+		opts.DeleteOriginals = checked
+	})
+
+	form.AddButton("[ Redrive ]", func() {
+		if opts.Target.Stream == "" && opts.Target.Subject == "" && opts.Target.RewriteFrom == "" {
+			v.ui.ShowError("Set a target stream, target subject or subject rewrite")
+			return
+		}
+		if err := opts.Target.Validate(); err != nil {
+			v.ui.ShowError(err.Error())
+			return
+		}
+
+		var start, end uint64
+		if len(v.marked) == 0 {
+			var err error
+			if start, err = strconv.ParseUint(startSeq, 10, 64); err != nil {
+				v.ui.ShowError("Start sequence is required when no messages are marked")
+				return
+			}
+			if endSeq != "" {
+				if end, err = strconv.ParseUint(endSeq, 10, 64); err != nil || end < start {
+					v.ui.ShowError(fmt.Sprintf("Invalid end sequence: %s", endSeq))
+					return
+				}
+			}
+		}
+
+		v.ui.CloseModal()
+		v.resolveRedrive(start, end, filter, opts)
+	})
+	form.AddButton("[ Cancel ]", func() {
+		v.ui.CloseModal()
+	})
+
+	form.SetBorder(true).
+		SetTitle(" Redrive Messages ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Center the form
+	centered := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(form, 23, 1, true).
+			AddItem(nil, 0, 1, false), 80, 1, true).
+		AddItem(nil, 0, 1, false)
+
+	v.ui.ShowModal(centered)
+}
+
+// resolveRedrive collects the sequences to redrive and asks for confirmation
+func (v *MessageView) resolveRedrive(start, end uint64, filter string, opts nats.RedriveOptions) {
+	if len(v.marked) > 0 {
+		seqs := make([]uint64, 0, len(v.marked))
+		for seq := range v.marked {
+			seqs = append(seqs, seq)
+		}
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] < seqs[j] })
+		v.confirmRedrive(seqs, opts)
+		return
+	}
+
+	v.ui.footer.Update("[yellow]Finding matching messages...[white]")
+	streamName := v.streamName
+
+	go func() {
+		seqs, err := v.ui.client.FindMessages(streamName, start, end, filter, 0)
+
+		v.ui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to find messages: %v", err))
+				return
+			}
+			if len(seqs) == 0 {
+				v.ui.ShowError("No messages matched the range and subject filter")
+				return
+			}
+			v.confirmRedrive(seqs, opts)
+		})
+	}()
+}
+
+func (v *MessageView) confirmRedrive(seqs []uint64, opts nats.RedriveOptions) {
+	target := opts.Target.Stream
+	if opts.Target.Subject != "" {
+		target = opts.Target.Subject
+	} else if target == "" {
+		target = fmt.Sprintf("%s* -> %s*", opts.Target.RewriteFrom, opts.Target.RewriteTo)
+	}
+
+	message := fmt.Sprintf("Redrive %d messages from '%s' to '%s'?", len(seqs), v.streamName, target)
+	if opts.DeleteOriginals {
+		message += "\n\nOriginals will be deleted after each publish is confirmed."
+	}
+
+	modal := components.ConfirmModal(
+		message,
+		func() {
+			v.ui.CloseModal()
+			v.performRedrive(seqs, opts)
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+
+	v.ui.ShowModal(modal)
+}
+
+func (v *MessageView) performRedrive(seqs []uint64, opts nats.RedriveOptions) {
+	ctx, cancel := context.WithCancel(context.Background())
+	streamName := v.streamName
+
+	progress := components.NewProgressModal("Redrive Progress", func() {
+		cancel()
+		v.ui.CloseModal()
+		v.marked = make(map[uint64]bool)
+		v.Refresh()
+	})
+	progress.SetStatus(fmt.Sprintf("[yellow]Redriving 0/%d messages...[white]", len(seqs)))
+	v.ui.ShowModal(progress)
+
+	go func() {
+		done, published, failed := 0, 0, 0
+
+		err := v.ui.client.RedriveMessages(ctx, streamName, seqs, opts, func(status nats.RedriveStatus) {
+			done++
+			var line string
+			switch {
+			case status.Published && status.Err != nil:
+				published++
+				failed++
+				line = fmt.Sprintf("[yellow]seq %d -> %s published, delete failed: %v[white]", status.Sequence, status.TargetSubject, status.Err)
+			case status.Err != nil:
+				failed++
+				line = fmt.Sprintf("[red]seq %d failed: %v[white]", status.Sequence, status.Err)
+			case status.Deleted:
+				published++
+				line = fmt.Sprintf("[green]seq %d -> %s moved[white]", status.Sequence, status.TargetSubject)
+			default:
+				published++
+				line = fmt.Sprintf("[green]seq %d -> %s copied[white]", status.Sequence, status.TargetSubject)
+			}
+
+			summary := fmt.Sprintf("[yellow]Redriving %d/%d messages...[white]  Published: %d  Failed: %d", done, len(seqs), published, failed)
+			v.ui.app.QueueUpdateDraw(func() {
+				progress.AddLine(line)
+				progress.SetStatus(summary)
+			})
+		})
+
+		v.ui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				progress.SetStatus(fmt.Sprintf("[red]Redrive interrupted after %d/%d messages: %v[white]", done, len(seqs), err))
+				return
+			}
+			progress.SetStatus(fmt.Sprintf("[green]Redrive complete[white]  Published: %d  Failed: %d", published, failed))
+		})
+	}()
+}
+
+func (v *MessageView) showImportDialog() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot import messages in read-only mode")
+		return
+	}
+
+	filePath := ""
+	rate := ""
+	opts := nats.ImportOptions{
+		Stream:          v.streamName,
+		PreserveHeaders: true,
+	}
+
+	form := tview.NewForm()
+	form.AddInputField("File (JSONL)", filePath, 40, nil, func(text string) {
+		filePath = text
+	})
+	form.AddInputField("Target Stream", opts.Stream, 30, nil, func(text string) {
+		opts.Stream = text
+	})
+	form.AddInputField("Subject (blank = original)", "", 30, nil, func(text string) {
+		opts.Subject = text
+	})
+	form.AddInputField("Rewrite From", "", 30, nil, func(text string) {
+		opts.RewriteFrom = text
+	})
+	form.AddInputField("Rewrite To", "", 30, nil, func(text string) {
+		opts.RewriteTo = text
+	})
+	form.AddCheckbox("Preserve Headers", opts.PreserveHeaders, func(checked bool) {
+		opts.PreserveHeaders = checked
+	})
+	form.AddCheckbox("Set Nats-Msg-Id", opts.SetMsgID, func(checked bool) {
+		opts.SetMsgID = checked
+	})
+	form.AddInputField("Rate (msgs/s, 0 = max)", rate, 10, nil, func(text string) {
+		rate = text
+	})
+	form.AddCheckbox("Original Timing", opts.ReplayOriginal, func(checked bool) {
+		opts.ReplayOriginal = checked
+	})
+
+	form.AddButton("[ Import ]", func() {
+		if strings.TrimSpace(filePath) == "" {
+			v.ui.ShowError("File path cannot be empty")
+			return
+		}
+		if rate != "" {
+			r, err := strconv.ParseFloat(rate, 64)
+			if err != nil || r < 0 {
+				v.ui.ShowError(fmt.Sprintf("Invalid rate: %s", rate))
+				return
+			}
+			opts.Rate = r
+		}
+		if err := opts.Validate(); err != nil {
+			v.ui.ShowError(err.Error())
+			return
+		}
+		v.ui.CloseModal()
+		v.importMessages(filePath, opts)
+	})
+	form.AddButton("[ Cancel ]", func() {
+		v.ui.CloseModal()
+	})
+
+	form.SetBorder(true).
+		SetTitle(" Import Messages ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Center the form
+	centered := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(form, 23, 1, true).
+			AddItem(nil, 0, 1, false), 80, 1, true).
+		AddItem(nil, 0, 1, false)
+
+	v.ui.ShowModal(centered)
+}
+
+func (v *MessageView) importMessages(filePath string, opts nats.ImportOptions) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Failed to open file: %v", err))
+		return
+	}
+	messages, err := nats.ReadArchivedMessages(file)
+	file.Close()
+	if err != nil {
+		v.ui.ShowError(fmt.Sprintf("Failed to read messages: %v", err))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Closing the dialog stops the import
+	progress := components.NewProgressModal("Import Progress", func() {
+		cancel()
+		v.ui.CloseModal()
+		v.Refresh()
+	})
+	progress.SetStatus(fmt.Sprintf("[yellow]Importing 0/%d messages...[white]", len(messages)))
+	v.ui.ShowModal(progress)
+
+	// Publish in background so replay timing doesn't block the UI
+	go func() {
+		result, err := v.ui.client.ImportMessages(ctx, messages, opts, func(done, total int, pubErr error) {
+			if pubErr != nil {
+				line := fmt.Sprintf("[red]message %d failed: %v[white]", done, pubErr)
+				v.ui.app.QueueUpdateDraw(func() {
+					progress.AddLine(line)
+				})
+			}
+			if done%50 != 0 && done != total && pubErr == nil {
+				return
+			}
+			v.ui.app.QueueUpdateDraw(func() {
+				progress.SetStatus(fmt.Sprintf("[yellow]Importing %d/%d messages...[white]", done, total))
+			})
+		})
+
+		v.ui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				progress.SetStatus(fmt.Sprintf("[red]Import interrupted after %d/%d messages: %v[white]", result.Published+result.Failed, len(messages), err))
+				return
+			}
+			progress.SetStatus(fmt.Sprintf("[green]Import complete[white]  Published: %d  Failed: %d", result.Published, result.Failed))
+		})
+	}()
+}
+
 // GetPrimitive returns the primitive for this view
 func (v *MessageView) GetPrimitive() tview.Primitive {
 	return v.flex
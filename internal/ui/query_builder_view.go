@@ -2,16 +2,19 @@ package ui
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
-	"regexp"
-	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/config"
+	"github.com/shubhamrasal/n2s/internal/filter"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/shubhamrasal/n2s/internal/ui/components"
 	"gopkg.in/yaml.v3"
 )
@@ -25,6 +28,7 @@ type QueryBuilderView struct {
 	statusText   *tview.TextView
 
 	// Filter criteria
+	filter        *filter.Expr // Built from the criteria when previewing
 	namePattern   string
 	ageOp         string
 	ageValue      string
@@ -33,11 +37,22 @@ type QueryBuilderView struct {
 	consumerValue string
 	messagesOp    string
 	messagesValue string
+	bytesOp       string
+	bytesValue    string
+	idleOp        string
+	idleValue     string
+	storage       string
+	replicasOp    string
+	replicasValue string
+	subject       string
+	metadata      string
+	lagOp         string
+	lagValue      string
+	expression    string
 
 	// Preview data
 	matchedStreams []*models.Stream
-	sortColumn     int
-	sortAscending  bool
+	sorter         *tableSorter
 }
 
 // NewQueryBuilderView creates a new query builder
@@ -52,8 +67,17 @@ func NewQueryBuilderView(ui *UIManager) *QueryBuilderView {
 		consumerValue: "",
 		messagesOp:    "any",
 		messagesValue: "",
-		sortColumn:    0,
-		sortAscending: true,
+		bytesOp:       "any",
+		idleOp:        "any",
+		storage:       "any",
+		replicasOp:    "any",
+		lagOp:         "any",
+		sorter: newTableSorter(
+			sortField{name: "name", header: "NAME"},
+			sortField{name: "age", header: "AGE", desc: true},
+			sortField{name: "msgs", header: "MSGS", desc: true},
+			sortField{name: "consumers", header: "CONSUMERS", desc: true},
+		),
 	}
 
 	view.buildUI()
@@ -65,80 +89,7 @@ func NewQueryBuilderView(ui *UIManager) *QueryBuilderView {
 func (v *QueryBuilderView) buildUI() {
 	// Create form for filter criteria
 	v.form = tview.NewForm()
-
-	// Name pattern input
-	v.form.AddInputField("Name Pattern", v.namePattern, 30, nil, func(text string) {
-		v.namePattern = text
-	})
-
-	// Age operator dropdown
-	ageOps := []string{"any", ">", "<", "="}
-	v.form.AddDropDown("Age Operator", ageOps, 0, func(option string, optionIndex int) {
-		v.ageOp = option
-	})
-
-	// Age value input
-	v.form.AddInputField("Age Value", v.ageValue, 10, tview.InputFieldInteger, func(text string) {
-		v.ageValue = text
-	})
-
-	// Age unit dropdown
-	ageUnits := []string{"m", "h"}
-	v.form.AddDropDown("Age Unit", ageUnits, 1, func(option string, optionIndex int) {
-		v.ageUnit = option
-	})
-
-	// Consumer operator dropdown
-	consumerOps := []string{"any", "=", ">", "<"}
-	v.form.AddDropDown("Consumer Op", consumerOps, 0, func(option string, optionIndex int) {
-		v.consumerOp = option
-	})
-
-	// Consumer value input
-	v.form.AddInputField("Consumer Value", v.consumerValue, 10, tview.InputFieldInteger, func(text string) {
-		v.consumerValue = text
-	})
-
-	// Messages operator dropdown
-	messagesOps := []string{"any", "=", ">", "<"}
-	v.form.AddDropDown("Messages Op", messagesOps, 0, func(option string, optionIndex int) {
-		v.messagesOp = option
-	})
-
-	// Messages value input
-	v.form.AddInputField("Messages Value", v.messagesValue, 15, tview.InputFieldInteger, func(text string) {
-		v.messagesValue = text
-	})
-
-	// Action buttons
-	v.form.AddButton("[ Preview Matches ]", func() {
-		v.previewMatches()
-		v.ui.app.SetFocus(v.form)
-	})
-
-	v.form.AddButton("[ Delete All ]", func() {
-		v.deleteMatched()
-	})
-
-	v.form.AddButton("[ Purge All ]", func() {
-		v.purgeMatched()
-	})
-
-	v.form.AddButton("[ Load Filter ]", func() {
-		v.showLoadFilterDialog()
-	})
-
-	v.form.AddButton("[ Save Filter ]", func() {
-		v.saveFilter()
-	})
-
-	v.form.AddButton("[ Clear Filter ]", func() {
-		v.clearFilter()
-	})
-
-	v.form.AddButton("[ Cancel ]", func() {
-		v.ui.ShowStreamList()
-	})
+	v.buildFormFields()
 
 	v.form.SetBorder(true).
 		SetTitle(" Bulk Operation - Filter Criteria ").
@@ -151,7 +102,7 @@ func (v *QueryBuilderView) buildUI() {
 		SetFixed(1, 0)
 
 	v.previewTable.SetBorder(true).
-		SetTitle(" Preview - Click column header to sort ").
+		SetTitle(" Preview - Click column header or o/O to sort ").
 		SetTitleAlign(tview.AlignCenter)
 
 	v.setupPreviewHeaders()
@@ -175,10 +126,12 @@ func (v *QueryBuilderView) buildUI() {
 		AddItem(v.previewTable, 0, 2, false)
 }
 
+// previewHeaders are the preview table's column headers
+var previewHeaders = []string{"NAME", "AGE", "MSGS", "CONSUMERS"}
+
 func (v *QueryBuilderView) setupPreviewHeaders() {
-	headers := []string{"NAME", "AGE", "MSGS", "CONSUMERS"}
-	for i, header := range headers {
-		cell := tview.NewTableCell(header).
+	for i, header := range previewHeaders {
+		cell := tview.NewTableCell(v.sorter.headerLabel(header)).
 			SetTextColor(tcell.ColorYellow).
 			SetAlign(tview.AlignLeft).
 			SetSelectable(true)
@@ -197,35 +150,98 @@ func (v *QueryBuilderView) setupKeybindings() {
 
 	// Make header clickable for sorting
 	v.previewTable.SetSelectedFunc(func(row, column int) {
-		if row == 0 {
-			// Header clicked - sort by this column
-			if v.sortColumn == column {
-				v.sortAscending = !v.sortAscending
-			} else {
-				v.sortColumn = column
-				v.sortAscending = true
-			}
+		// Header clicked - sort by this column
+		if row == 0 && v.sorter.sortByHeader(previewHeaders[column]) {
 			v.sortPreview()
 		}
 	})
+	v.previewTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.sortPreview()
+			return nil
+		}
+		return event
+	})
 }
 
 func (v *QueryBuilderView) previewMatches() {
-	// Get all streams
-	streams, err := v.ui.client.ListStreams()
+	criteria, err := v.currentFilter("")
+	if err == nil {
+		v.filter, err = filter.FromSaved(criteria)
+	}
 	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+		v.ui.ShowError(fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	// Get all streams, reusing a fresh listing
+	streams, ok := v.ui.cache.streamList()
+	if ok {
+		v.matchStreams(streams)
+		return
+	}
+
+	v.statusText.SetText("[yellow]Listing streams...[white]")
+	v.ui.refresh("query-builder", func(client *nats.Client) func() {
+		streams, err := client.ListStreams()
+		return func() {
+			if err != nil {
+				v.updateStatus(len(v.matchedStreams))
+				v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+				return
+			}
+			v.ui.cache.putStreamList(streams)
+			v.matchStreams(streams)
+		}
+	})
+}
+
+// matchStreams shows the streams matching the filter. Consumer lag needs the consumers
+// of every stream, which are listed in the background first.
+func (v *QueryBuilderView) matchStreams(streams []*models.Stream) {
+	var names []string
+	if v.filter.NeedsConsumers() {
+		names = v.ui.staleConsumerLists(streams)
+	}
+	if len(names) == 0 {
+		v.showMatches(streams)
 		return
 	}
 
-	// Filter streams
+	v.statusText.SetText(fmt.Sprintf("[yellow]Listing consumers of %d streams...[white]", len(names)))
+	v.ui.refresh("query-builder", func(client *nats.Client) func() {
+		consumers, err := client.ListConsumersOf(names)
+		return func() {
+			if err != nil {
+				v.updateStatus(len(v.matchedStreams))
+				v.ui.ShowError(fmt.Sprintf("Failed to list consumers: %v", err))
+				return
+			}
+			v.ui.cache.putConsumerLists(consumers)
+			v.showMatches(streams)
+		}
+	})
+}
+
+func (v *QueryBuilderView) showMatches(streams []*models.Stream) {
 	v.matchedStreams = v.filterStreams(streams)
 
-	// Update preview table
-	v.updatePreviewTable()
+	// Update preview table in the remembered sort order
+	v.sortPreview()
 	v.updateStatus(len(v.matchedStreams))
 }
 
+// staleConsumerLists returns the streams whose consumers aren't cached fresh
+func (ui *UIManager) staleConsumerLists(streams []*models.Stream) []string {
+	var names []string
+	for _, stream := range streams {
+		if _, fetchedAt := ui.cache.consumerList(stream.Name); !ui.cache.fresh(fetchedAt) {
+			names = append(names, stream.Name)
+		}
+	}
+	return names
+}
+
 func (v *QueryBuilderView) filterStreams(streams []*models.Stream) []*models.Stream {
 	var matched []*models.Stream
 
@@ -238,102 +254,83 @@ func (v *QueryBuilderView) filterStreams(streams []*models.Stream) []*models.Str
 	return matched
 }
 
+// matchesFilter evaluates the filter built from the criteria, the same evaluator the
+// stream list's filter expressions use
 func (v *QueryBuilderView) matchesFilter(stream *models.Stream) bool {
-	// Name pattern matching (only if not wildcard)
-	if v.namePattern != "*" && v.namePattern != "" {
-		// Convert wildcard to regex
-		pattern := strings.ReplaceAll(v.namePattern, "*", ".*")
-		pattern = "^" + pattern + "$"
-		matched, err := regexp.MatchString(pattern, stream.Name)
-		if err != nil || !matched {
-			return false
-		}
-	}
-
-	// Age filtering (only if operator set and value provided)
-	if v.ageOp != "any" && v.ageValue != "" {
-		age := time.Since(stream.State.FirstTime)
-		var targetDuration time.Duration
-
-		// Parse age value
-		var ageVal int
-		if n, _ := fmt.Sscanf(v.ageValue, "%d", &ageVal); n == 0 {
-			return true // Skip if invalid value
-		}
-
-		if v.ageUnit == "m" {
-			targetDuration = time.Duration(ageVal) * time.Minute
-		} else {
-			targetDuration = time.Duration(ageVal) * time.Hour
-		}
-
-		if !v.compareAge(age, v.ageOp, targetDuration) {
-			return false
-		}
-	}
-
-	// Consumer filtering (only if operator set and value provided)
-	if v.consumerOp != "any" && v.consumerValue != "" {
-		var consVal int
-		if n, _ := fmt.Sscanf(v.consumerValue, "%d", &consVal); n == 0 {
-			return true // Skip if invalid value
-		}
+	consumers, _ := v.ui.cache.consumerList(stream.Name)
+	return v.filter.Match(stream, consumers)
+}
 
-		if !v.compareInt(stream.Consumers, v.consumerOp, consVal) {
-			return false
+// currentFilter collects the criteria in the form. Criteria without a value are
+// left unset; values that aren't numbers or counts are rejected.
+func (v *QueryBuilderView) currentFilter(name string) (models.SavedFilter, error) {
+	f := models.SavedFilter{
+		Name:        name,
+		NamePattern: v.namePattern,
+		AgeOp:       v.ageOp,
+		AgeUnit:     v.ageUnit,
+		ConsumerOp:  v.consumerOp,
+		MessagesOp:  v.messagesOp,
+		BytesOp:     v.bytesOp,
+		BytesValue:  strings.TrimSpace(v.bytesValue),
+		IdleOp:      v.idleOp,
+		IdleValue:   strings.TrimSpace(v.idleValue),
+		Storage:     v.storage,
+		ReplicasOp:  v.replicasOp,
+		Subject:     strings.TrimSpace(v.subject),
+		Metadata:    strings.TrimSpace(v.metadata),
+		LagOp:       v.lagOp,
+		Expression:  strings.TrimSpace(v.expression),
+	}
+	if f.Storage == "any" {
+		f.Storage = ""
+	}
+
+	// Counts accept the stream list's notation, e.g. 10k or 1e6
+	numbers := []struct {
+		label string
+		op    *string
+		text  string
+		count bool
+		max   int64 // Largest value the saved filter field holds
+		set   func(n int64)
+	}{
+		{"Age Value", &f.AgeOp, v.ageValue, false, math.MaxInt, func(n int64) { f.AgeValue = int(n) }},
+		{"Consumer Value", &f.ConsumerOp, v.consumerValue, true, math.MaxInt, func(n int64) { f.ConsumerValue = int(n) }},
+		{"Messages Value", &f.MessagesOp, v.messagesValue, true, math.MaxInt64, func(n int64) { f.MessagesValue = n }},
+		{"Replicas Value", &f.ReplicasOp, v.replicasValue, false, math.MaxInt, func(n int64) { f.ReplicasValue = int(n) }},
+		{"Lag Value", &f.LagOp, v.lagValue, true, math.MaxInt64, func(n int64) { f.LagValue = n }},
+	}
+	for _, number := range numbers {
+		text := strings.TrimSpace(number.text)
+		if text == "" {
+			*number.op = "any"
+			continue
 		}
-	}
-
-	// Messages filtering (only if operator set and value provided)
-	if v.messagesOp != "any" && v.messagesValue != "" {
-		var msgVal int64
-		if n, _ := fmt.Sscanf(v.messagesValue, "%d", &msgVal); n == 0 {
-			return true // Skip if invalid value
+		if number.count {
+			// float64(MaxInt64) rounds up to 2^63, which no longer fits in an int64
+			n, err := filter.ParseCount(text)
+			if err != nil || n < 0 || n != math.Trunc(n) || n >= float64(number.max) {
+				return f, fmt.Errorf("%s: %q is not a count", number.label, text)
+			}
+			number.set(int64(n))
+			continue
 		}
-
-		if !v.compareInt64(int64(stream.State.Messages), v.messagesOp, msgVal) {
-			return false
+		n, err := strconv.ParseInt(text, 10, 64)
+		if err != nil || n < 0 || n > number.max {
+			return f, fmt.Errorf("%s: %q is not a number", number.label, text)
 		}
+		number.set(n)
 	}
 
-	return true
-}
-
-func (v *QueryBuilderView) compareAge(actual time.Duration, op string, target time.Duration) bool {
-	switch op {
-	case ">":
-		return actual > target
-	case "<":
-		return actual < target
-	case "=":
-		// Within 10% tolerance
-		return actual >= target*9/10 && actual <= target*11/10
+	if f.BytesValue == "" {
+		f.BytesOp = "any"
 	}
-	return true
-}
-
-func (v *QueryBuilderView) compareInt(actual int, op string, target int) bool {
-	switch op {
-	case "=":
-		return actual == target
-	case ">":
-		return actual > target
-	case "<":
-		return actual < target
+	if f.IdleValue == "" {
+		f.IdleOp = "any"
 	}
-	return true
-}
 
-func (v *QueryBuilderView) compareInt64(actual int64, op string, target int64) bool {
-	switch op {
-	case "=":
-		return actual == target
-	case ">":
-		return actual > target
-	case "<":
-		return actual < target
-	}
-	return true
+	return f, nil
 }
 
 func (v *QueryBuilderView) updatePreviewTable() {
@@ -345,7 +342,7 @@ func (v *QueryBuilderView) updatePreviewTable() {
 	// Add matched streams
 	for i, stream := range v.matchedStreams {
 		row := i + 1
-		age := time.Since(stream.State.FirstTime)
+		age := time.Since(filter.FirstMessageTime(stream))
 
 		v.previewTable.SetCell(row, 0, tview.NewTableCell(stream.Name))
 		v.previewTable.SetCell(row, 1, tview.NewTableCell(formatDuration(age)))
@@ -355,29 +352,18 @@ func (v *QueryBuilderView) updatePreviewTable() {
 }
 
 func (v *QueryBuilderView) sortPreview() {
-	if len(v.matchedStreams) == 0 {
-		return
-	}
-
-	sort.Slice(v.matchedStreams, func(i, j int) bool {
-		var less bool
-
-		switch v.sortColumn {
-		case 0: // Name
-			less = v.matchedStreams[i].Name < v.matchedStreams[j].Name
-		case 1: // Age
-			less = v.matchedStreams[i].State.FirstTime.Before(v.matchedStreams[j].State.FirstTime)
-		case 2: // Messages
-			less = v.matchedStreams[i].State.Messages < v.matchedStreams[j].State.Messages
-		case 3: // Consumers
-			less = v.matchedStreams[i].Consumers < v.matchedStreams[j].Consumers
-		}
+	v.setupPreviewHeaders()
 
-		if !v.sortAscending {
-			less = !less
+	sortRows(v.sorter, v.matchedStreams, func(field string, a, b *models.Stream) bool {
+		switch field {
+		case "age":
+			return filter.FirstMessageTime(a).After(filter.FirstMessageTime(b))
+		case "msgs":
+			return a.State.Messages < b.State.Messages
+		case "consumers":
+			return a.Consumers < b.Consumers
 		}
-
-		return less
+		return a.Name < b.Name
 	})
 
 	v.updatePreviewTable()
@@ -440,6 +426,7 @@ func (v *QueryBuilderView) performBulkDelete() {
 			failCount++
 		} else {
 			successCount++
+			v.ui.cache.invalidate(stream.Name)
 		}
 	}
 
@@ -503,6 +490,7 @@ func (v *QueryBuilderView) performBulkPurge() {
 			failCount++
 		} else {
 			successCount++
+			v.ui.cache.invalidate(stream.Name)
 		}
 	}
 
@@ -521,6 +509,21 @@ func (v *QueryBuilderView) performBulkPurge() {
 	v.previewMatches()
 }
 
+// editMatched opens the bulk edit form for the matched streams
+func (v *QueryBuilderView) editMatched() {
+	if len(v.matchedStreams) == 0 {
+		v.ui.ShowError("No streams matched. Press 'Preview Matches' first.")
+		return
+	}
+
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot edit in read-only mode")
+		return
+	}
+
+	v.ui.ShowBulkEdit(v.matchedStreams)
+}
+
 func (v *QueryBuilderView) saveFilter() {
 	// Show input dialog for filter name
 	v.ui.ShowInputDialog("Save Filter", "Filter Name:", "", func(name string) {
@@ -529,25 +532,18 @@ func (v *QueryBuilderView) saveFilter() {
 			return
 		}
 
-		filter := models.SavedFilter{
-			Name:          name,
-			NamePattern:   v.namePattern,
-			AgeOp:         v.ageOp,
-			AgeValue:      0,
-			AgeUnit:       v.ageUnit,
-			ConsumerOp:    v.consumerOp,
-			ConsumerValue: 0,
-			MessagesOp:    v.messagesOp,
-			MessagesValue: 0,
+		saved, err := v.currentFilter(name)
+		if err == nil {
+			// Only save filters that can be evaluated
+			_, err = filter.FromSaved(saved)
+		}
+		if err != nil {
+			v.ui.ShowError(fmt.Sprintf("Invalid filter: %v", err))
+			return
 		}
-
-		// Parse integer values
-		fmt.Sscanf(v.ageValue, "%d", &filter.AgeValue)
-		fmt.Sscanf(v.consumerValue, "%d", &filter.ConsumerValue)
-		fmt.Sscanf(v.messagesValue, "%d", &filter.MessagesValue)
 
 		// Save to config file
-		if err := v.saveFilterToFile(filter); err != nil {
+		if err := v.saveFilterToFile(saved); err != nil {
 			v.ui.ShowError(fmt.Sprintf("Failed to save filter: %v", err))
 			return
 		}
@@ -563,13 +559,11 @@ func (v *QueryBuilderView) saveFilter() {
 }
 
 func (v *QueryBuilderView) saveFilterToFile(filter models.SavedFilter) error {
-	homeDir, err := os.UserHomeDir()
+	filterPath, err := config.FiltersPath()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return err
 	}
 
-	filterPath := filepath.Join(homeDir, ".config", "n2s", "filters.yaml")
-
 	// Ensure directory exists first
 	if err := os.MkdirAll(filepath.Dir(filterPath), 0755); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
@@ -605,27 +599,7 @@ func (v *QueryBuilderView) saveFilterToFile(filter models.SavedFilter) error {
 }
 
 func (v *QueryBuilderView) loadSavedFilters() ([]models.SavedFilter, error) {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return nil, err
-	}
-
-	filterPath := filepath.Join(homeDir, ".config", "n2s", "filters.yaml")
-
-	data, err := os.ReadFile(filterPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return []models.SavedFilter{}, nil
-		}
-		return nil, err
-	}
-
-	var config models.FilterConfig
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, err
-	}
-
-	return config.Filters, nil
+	return config.LoadFilters()
 }
 
 func (v *QueryBuilderView) showLoadFilterDialog() {
@@ -680,27 +654,23 @@ func (v *QueryBuilderView) showLoadFilterDialog() {
 	v.ui.ShowModal(centered)
 }
 
-func (v *QueryBuilderView) loadFilter(filter models.SavedFilter) {
-	v.namePattern = filter.NamePattern
-	v.ageOp = filter.AgeOp
-	if filter.AgeValue > 0 {
-		v.ageValue = fmt.Sprintf("%d", filter.AgeValue)
-	} else {
-		v.ageValue = ""
-	}
-	v.ageUnit = filter.AgeUnit
-	v.consumerOp = filter.ConsumerOp
-	if filter.ConsumerValue > 0 || filter.ConsumerOp == "=" {
-		v.consumerValue = fmt.Sprintf("%d", filter.ConsumerValue)
-	} else {
-		v.consumerValue = ""
-	}
-	v.messagesOp = filter.MessagesOp
-	if filter.MessagesValue > 0 || filter.MessagesOp == "=" {
-		v.messagesValue = fmt.Sprintf("%d", filter.MessagesValue)
-	} else {
-		v.messagesValue = ""
-	}
+func (v *QueryBuilderView) loadFilter(saved models.SavedFilter) {
+	v.namePattern = saved.NamePattern
+	v.ageOp, v.ageValue = savedNumber(saved.AgeOp, int64(saved.AgeValue))
+	v.ageUnit = saved.AgeUnit
+	v.consumerOp, v.consumerValue = savedNumber(saved.ConsumerOp, int64(saved.ConsumerValue))
+	v.messagesOp, v.messagesValue = savedNumber(saved.MessagesOp, saved.MessagesValue)
+	v.bytesOp, v.bytesValue = savedOp(saved.BytesOp), saved.BytesValue
+	v.idleOp, v.idleValue = savedOp(saved.IdleOp), saved.IdleValue
+	v.storage = saved.Storage
+	if v.storage == "" {
+		v.storage = "any"
+	}
+	v.replicasOp, v.replicasValue = savedNumber(saved.ReplicasOp, int64(saved.ReplicasValue))
+	v.subject = saved.Subject
+	v.metadata = saved.Metadata
+	v.lagOp, v.lagValue = savedNumber(saved.LagOp, saved.LagValue)
+	v.expression = saved.Expression
 
 	// Rebuild form with loaded values
 	v.form.Clear(true)
@@ -710,6 +680,24 @@ func (v *QueryBuilderView) loadFilter(filter models.SavedFilter) {
 	v.previewMatches()
 }
 
+// savedOp returns a saved operator, "any" if it is unset
+func savedOp(op string) string {
+	if op == "" {
+		return "any"
+	}
+	return op
+}
+
+// savedNumber returns a saved operator and the value to show for it, empty if the
+// criterion is unset
+func savedNumber(op string, value int64) (string, string) {
+	op = savedOp(op)
+	if op == "any" {
+		return op, ""
+	}
+	return op, strconv.FormatInt(value, 10)
+}
+
 // Show shows the query builder
 func (v *QueryBuilderView) Show() {
 	v.ui.currentPage = "query-builder"
@@ -728,6 +716,18 @@ func (v *QueryBuilderView) clearFilter() {
 	v.consumerValue = ""
 	v.messagesOp = "any"
 	v.messagesValue = ""
+	v.bytesOp = "any"
+	v.bytesValue = ""
+	v.idleOp = "any"
+	v.idleValue = ""
+	v.storage = "any"
+	v.replicasOp = "any"
+	v.replicasValue = ""
+	v.subject = ""
+	v.metadata = ""
+	v.lagOp = "any"
+	v.lagValue = ""
+	v.expression = ""
 
 	// Clear matched streams
 	v.matchedStreams = nil
@@ -748,51 +748,104 @@ func (v *QueryBuilderView) clearFilter() {
 	v.ui.app.ForceDraw()
 }
 
+// Operators offered for criteria
+var (
+	ageOps    = []string{"any", ">", "<", "="}
+	numberOps = []string{"any", "=", ">", "<"}
+)
+
+// optionIndex returns the index of a dropdown option, 0 if it isn't one
+func optionIndex(options []string, option string) int {
+	for i, o := range options {
+		if o == option {
+			return i
+		}
+	}
+	return 0
+}
+
 func (v *QueryBuilderView) buildFormFields() {
 	// Name pattern input
 	v.form.AddInputField("Name Pattern", v.namePattern, 30, nil, func(text string) {
 		v.namePattern = text
 	})
 
-	// Age operator dropdown
-	ageOps := []string{"any", ">", "<", "="}
-	v.form.AddDropDown("Age Operator", ageOps, 0, func(option string, optionIndex int) {
+	// Age since the stream was created
+	v.form.AddDropDown("Age Operator", ageOps, optionIndex(ageOps, v.ageOp), func(option string, optionIndex int) {
 		v.ageOp = option
 	})
-
-	// Age value input
 	v.form.AddInputField("Age Value", v.ageValue, 10, tview.InputFieldInteger, func(text string) {
 		v.ageValue = text
 	})
-
-	// Age unit dropdown
 	ageUnits := []string{"m", "h"}
-	v.form.AddDropDown("Age Unit", ageUnits, 1, func(option string, optionIndex int) {
+	v.form.AddDropDown("Age Unit", ageUnits, optionIndex(ageUnits, v.ageUnit), func(option string, optionIndex int) {
 		v.ageUnit = option
 	})
 
-	// Consumer operator dropdown
-	consumerOps := []string{"any", "=", ">", "<"}
-	v.form.AddDropDown("Consumer Op", consumerOps, 0, func(option string, optionIndex int) {
+	// Consumers
+	v.form.AddDropDown("Consumer Op", numberOps, optionIndex(numberOps, v.consumerOp), func(option string, optionIndex int) {
 		v.consumerOp = option
 	})
-
-	// Consumer value input
-	v.form.AddInputField("Consumer Value", v.consumerValue, 10, tview.InputFieldInteger, func(text string) {
+	v.form.AddInputField("Consumer Value", v.consumerValue, 10, nil, func(text string) {
 		v.consumerValue = text
 	})
 
-	// Messages operator dropdown
-	messagesOps := []string{"any", "=", ">", "<"}
-	v.form.AddDropDown("Messages Op", messagesOps, 0, func(option string, optionIndex int) {
+	// Messages, e.g. 1M
+	v.form.AddDropDown("Messages Op", numberOps, optionIndex(numberOps, v.messagesOp), func(option string, optionIndex int) {
 		v.messagesOp = option
 	})
-
-	// Messages value input
-	v.form.AddInputField("Messages Value", v.messagesValue, 15, tview.InputFieldInteger, func(text string) {
+	v.form.AddInputField("Messages Value", v.messagesValue, 15, nil, func(text string) {
 		v.messagesValue = text
 	})
 
+	// Bytes, e.g. 2GB
+	v.form.AddDropDown("Bytes Op", numberOps, optionIndex(numberOps, v.bytesOp), func(option string, optionIndex int) {
+		v.bytesOp = option
+	})
+	v.form.AddInputField("Bytes Value", v.bytesValue, 10, nil, func(text string) {
+		v.bytesValue = text
+	})
+
+	// Time since the last message, e.g. 7d
+	v.form.AddDropDown("Idle Op", ageOps, optionIndex(ageOps, v.idleOp), func(option string, optionIndex int) {
+		v.idleOp = option
+	})
+	v.form.AddInputField("Idle Value", v.idleValue, 10, nil, func(text string) {
+		v.idleValue = text
+	})
+
+	storageTypes := []string{"any", "file", "memory"}
+	v.form.AddDropDown("Storage", storageTypes, optionIndex(storageTypes, v.storage), func(option string, optionIndex int) {
+		v.storage = option
+	})
+
+	v.form.AddDropDown("Replicas Op", numberOps, optionIndex(numberOps, v.replicasOp), func(option string, optionIndex int) {
+		v.replicasOp = option
+	})
+	v.form.AddInputField("Replicas Value", v.replicasValue, 5, tview.InputFieldInteger, func(text string) {
+		v.replicasValue = text
+	})
+
+	v.form.AddInputField("Subject", v.subject, 30, nil, func(text string) {
+		v.subject = text
+	})
+	v.form.AddInputField("Metadata (k=v)", v.metadata, 30, nil, func(text string) {
+		v.metadata = text
+	})
+
+	// Highest pending count of the stream's consumers
+	v.form.AddDropDown("Lag Op", numberOps, optionIndex(numberOps, v.lagOp), func(option string, optionIndex int) {
+		v.lagOp = option
+	})
+	v.form.AddInputField("Lag Value", v.lagValue, 15, nil, func(text string) {
+		v.lagValue = text
+	})
+
+	// Any filter expression the stream list accepts
+	v.form.AddInputField("Expression", v.expression, 30, nil, func(text string) {
+		v.expression = text
+	})
+
 	// Action buttons
 	v.form.AddButton("[ Preview Matches ]", func() {
 		v.previewMatches()
@@ -807,6 +860,14 @@ func (v *QueryBuilderView) buildFormFields() {
 		v.purgeMatched()
 	})
 
+	v.form.AddButton("[ Edit All ]", func() {
+		v.editMatched()
+	})
+
+	v.form.AddButton("[ Consumer Mode ]", func() {
+		v.ui.ShowConsumerQuery()
+	})
+
 	v.form.AddButton("[ Load Filter ]", func() {
 		v.showLoadFilterDialog()
 	})
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// refreshTimeout bounds a single background refresh
+const refreshTimeout = 10 * time.Second
+
+// loadFunc fetches a view's data in a background worker. The client is bound to the
+// refresh's timeout and cancellation. It returns a function that applies the data to
+// the view on the UI thread; apply must be quick and must not do network I/O.
+type loadFunc func(client *nats.Client) (apply func())
+
+// refresh fetches data for page in a background worker, cancelling any refresh still
+// running. The result is only applied if the page is still shown and no newer refresh
+// was started, so keystrokes never wait on the network.
+func (ui *UIManager) refresh(page string, load loadFunc) {
+	if ui.cancelRefresh != nil {
+		ui.cancelRefresh()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	ui.cancelRefresh = cancel
+	ui.refreshGen++
+	gen := ui.refreshGen
+
+	client := ui.client.WithContext(ctx)
+	go func() {
+		defer cancel()
+		apply := load(client)
+
+		// Superseded by a newer refresh, drop the result
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return
+		}
+
+		ui.app.QueueUpdateDraw(func() {
+			if gen != ui.refreshGen {
+				return
+			}
+			ui.cancelRefresh = nil
+			if ui.currentPage == page && apply != nil {
+				apply()
+			}
+		})
+	}()
+}
+
+// refreshing reports whether a background refresh is still running
+func (ui *UIManager) refreshing() bool {
+	return ui.cancelRefresh != nil
+}
+
+// refreshAccountUsage fetches account usage in the background for the header
+// and, when it is shown, the account view
+func (ui *UIManager) refreshAccountUsage() {
+	client := ui.client
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+
+		account, err := client.WithContext(ctx).GetAccountInfo()
+
+		ui.app.QueueUpdateDraw(func() {
+			if client != ui.client {
+				// Context switched while loading
+				return
+			}
+			if err != nil {
+				// Errors just hide the usage bar
+				ui.header.SetAccountUsage("", -1)
+				ui.updateHeader()
+				return
+			}
+			ui.accountDomain = account.Domain
+			ui.header.SetAccountUsage(accountUsage(account))
+			ui.updateHeader()
+			if ui.currentPage == "account" {
+				ui.accountView.SetAccount(account)
+			}
+		})
+	}()
+}
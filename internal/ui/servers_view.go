@@ -0,0 +1,292 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+)
+
+// serverPingTimeout bounds how long we wait for servers to answer system requests
+const serverPingTimeout = 2 * time.Second
+
+// ServersView lists every server reachable through the system account
+type ServersView struct {
+	ui         *UIManager
+	mainFlex   *tview.Flex
+	table      *tview.Table
+	detailView *tview.TextView
+	servers    []*models.ServerStatus
+	rawKind    string // Which raw response is shown in the detail panel
+	loading    bool
+	expanded   bool
+}
+
+// NewServersView creates a new servers view
+func NewServersView(ui *UIManager) *ServersView {
+	view := &ServersView{
+		ui:      ui,
+		rawKind: models.ServerVarz,
+	}
+
+	view.table = tview.NewTable().
+		SetBorders(false).
+		SetSelectable(true, false).
+		SetFixed(1, 0).
+		SetSelectionChangedFunc(func(row, column int) {
+			view.updateDetail(row)
+		})
+	view.table.SetBorder(true).
+		SetTitle(" Servers ").
+		SetTitleAlign(tview.AlignCenter)
+
+	// Raw JSON drill-down
+	view.detailView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWordWrap(true)
+	view.detailView.SetBorder(true).
+		SetTitleAlign(tview.AlignCenter)
+
+	view.mainFlex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.table, 0, 1, true).
+		AddItem(view.detailView, 0, 1, false)
+
+	view.setupKeybindings()
+	view.setupHeaders()
+
+	return view
+}
+
+func (v *ServersView) setupHeaders() {
+	headers := []string{"NAME", "CLUSTER", "VERSION", "UPTIME", "CPU", "MEM", "CONNS", "JS STORE", "JS MEM", "STREAMS", "HEALTH"}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.table.SetCell(0, i, cell)
+	}
+}
+
+func (v *ServersView) setupKeybindings() {
+	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			v.expandDetail()
+			return nil
+		case tcell.KeyEsc:
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'V':
+				v.showRaw(models.ServerVarz)
+				return nil
+			case 'J':
+				v.showRaw(models.ServerJsz)
+				return nil
+			case 'H':
+				v.showRaw(models.ServerHealthz)
+				return nil
+			case 'r':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+
+	v.detailView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc, tcell.KeyEnter:
+			v.collapseDetail()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'V':
+				v.showRaw(models.ServerVarz)
+				return nil
+			case 'J':
+				v.showRaw(models.ServerJsz)
+				return nil
+			case 'H':
+				v.showRaw(models.ServerHealthz)
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+// Refresh pings all servers in the background and updates the table when they answer
+func (v *ServersView) Refresh() {
+	if v.loading {
+		return
+	}
+	v.loading = true
+	v.updateFooter()
+
+	client := v.ui.client
+	go func() {
+		servers, err := client.GetServers(serverPingTimeout)
+		v.ui.app.QueueUpdateDraw(func() {
+			v.loading = false
+			if err != nil {
+				v.servers = nil
+				v.updateTable()
+				v.detailView.SetText(fmt.Sprintf("[red]%s[white]\n\n[gray]Use a context with system account credentials to monitor servers.[white]", tview.Escape(err.Error())))
+				v.updateFooter()
+				return
+			}
+			v.setServers(servers)
+		})
+	}()
+}
+
+func (v *ServersView) setServers(servers []*models.ServerStatus) {
+	// Keep the selection on the same server across refreshes
+	var selectedID string
+	if row, _ := v.table.GetSelection(); row > 0 && row <= len(v.servers) {
+		selectedID = v.servers[row-1].ID
+	}
+
+	v.servers = servers
+	v.updateTable()
+
+	row := 1
+	for i, s := range v.servers {
+		if s.ID == selectedID {
+			row = i + 1
+			break
+		}
+	}
+	if len(v.servers) > 0 {
+		v.table.Select(row, 0)
+	}
+	v.updateDetail(row)
+	v.updateFooter()
+}
+
+func (v *ServersView) updateTable() {
+	// Clear existing rows (keep header)
+	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+		v.table.RemoveRow(row)
+	}
+
+	for i, s := range v.servers {
+		row := i + 1
+
+		health := "[green]ok"
+		if !s.Healthy {
+			status := s.HealthStatus
+			if status == "" {
+				status = "unknown"
+			}
+			health = "[red]" + status
+		}
+
+		jsStore, jsMem, streams := "-", "-", "-"
+		if s.JetStream {
+			jsStore = fmt.Sprintf("%s/%s", formatBytes(s.JSStore), formatByteLimit(s.JSMaxStore))
+			jsMem = fmt.Sprintf("%s/%s", formatBytes(s.JSMemory), formatByteLimit(s.JSMaxMemory))
+			streams = fmt.Sprintf("%d", s.Streams)
+		}
+
+		name := s.Name
+		if s.MetaLeader != "" && s.MetaLeader == s.Name {
+			name += " *"
+		}
+
+		v.table.SetCell(row, 0, tview.NewTableCell(name))
+		v.table.SetCell(row, 1, tview.NewTableCell(s.Cluster))
+		v.table.SetCell(row, 2, tview.NewTableCell(s.Version))
+		v.table.SetCell(row, 3, tview.NewTableCell(s.Uptime))
+		v.table.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%.1f%%", s.CPU)))
+		v.table.SetCell(row, 5, tview.NewTableCell(formatBytes(uint64(s.Mem))))
+		v.table.SetCell(row, 6, tview.NewTableCell(fmt.Sprintf("%d", s.Connections)))
+		v.table.SetCell(row, 7, tview.NewTableCell(jsStore))
+		v.table.SetCell(row, 8, tview.NewTableCell(jsMem))
+		v.table.SetCell(row, 9, tview.NewTableCell(streams))
+		v.table.SetCell(row, 10, tview.NewTableCell(health))
+	}
+}
+
+func (v *ServersView) updateDetail(row int) {
+	v.detailView.SetTitle(fmt.Sprintf(" %s (V: VARZ  J: JSZ  H: HEALTHZ) ", v.rawKind))
+
+	if row <= 0 || row > len(v.servers) {
+		v.detailView.SetText("[gray]Select a server to view its raw response[white]")
+		return
+	}
+
+	s := v.servers[row-1]
+	raw, ok := s.Raw[v.rawKind]
+	if !ok {
+		v.detailView.SetText(fmt.Sprintf("[gray]No %s response from %s[white]", v.rawKind, s.Name))
+		return
+	}
+
+	payload := string(raw)
+	var pretty interface{}
+	if json.Unmarshal(raw, &pretty) == nil {
+		if formatted, err := json.MarshalIndent(pretty, "", "  "); err == nil {
+			payload = string(formatted)
+		}
+	}
+
+	header := fmt.Sprintf("[yellow]Server:[white] %s  [yellow]ID:[white] %s  [yellow]Host:[white] %s", s.Name, s.ID, s.Host)
+	if s.HealthError != "" {
+		header += fmt.Sprintf("\n[red]Health: %s[white]", tview.Escape(s.HealthError))
+	}
+	v.detailView.SetText(header + "\n\n" + tview.Escape(payload))
+	v.detailView.ScrollToBeginning()
+}
+
+func (v *ServersView) showRaw(kind string) {
+	v.rawKind = kind
+	row, _ := v.table.GetSelection()
+	v.updateDetail(row)
+}
+
+// expandDetail shows the raw JSON of the selected server full screen
+func (v *ServersView) expandDetail() {
+	row, _ := v.table.GetSelection()
+	if row <= 0 || row > len(v.servers) {
+		return
+	}
+
+	v.expanded = true
+	v.mainFlex.ResizeItem(v.table, 0, 0)
+	v.ui.app.SetFocus(v.detailView)
+	v.updateFooter()
+}
+
+func (v *ServersView) collapseDetail() {
+	v.expanded = false
+	v.mainFlex.ResizeItem(v.table, 0, 1)
+	v.ui.app.SetFocus(v.table)
+	v.updateFooter()
+}
+
+func (v *ServersView) updateFooter() {
+	if v.expanded {
+		v.ui.footer.Update("V: VARZ  J: JSZ  H: HEALTHZ  ↑/↓: Scroll  Esc: Back to servers")
+		return
+	}
+
+	status := fmt.Sprintf("[%d servers]", len(v.servers))
+	if v.loading {
+		status = "[yellow]Pinging servers...[white]"
+	}
+	v.ui.footer.Update(fmt.Sprintf("Enter: Raw JSON  V/J/H: VARZ/JSZ/HEALTHZ  r: Refresh  Esc: Back  %s", status))
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *ServersView) GetPrimitive() tview.Primitive {
+	return v.mainFlex
+}
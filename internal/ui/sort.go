@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// sortField is a field a table can be sorted by
+type sortField struct {
+	name   string // Shown in the footer
+	header string // Header of the column showing the sort indicator, "" if it has no column
+	desc   bool   // Direction used when the field is first selected
+}
+
+// tableSorter holds the field a table is sorted by. 'o' cycles the field and 'O'
+// reverses the direction, and the sorted column's header shows ▲ or ▼. Views keep
+// their sorter for the whole session, so the sort is remembered when navigating.
+type tableSorter struct {
+	fields []sortField
+	field  int
+	desc   bool
+}
+
+func newTableSorter(fields ...sortField) *tableSorter {
+	return &tableSorter{fields: fields, desc: fields[0].desc}
+}
+
+// name returns the name of the field sorted by
+func (s *tableSorter) name() string {
+	return s.fields[s.field].name
+}
+
+// next sorts by the next field, in that field's default direction
+func (s *tableSorter) next() {
+	s.field = (s.field + 1) % len(s.fields)
+	s.desc = s.fields[s.field].desc
+}
+
+// reverse toggles the sort direction
+func (s *tableSorter) reverse() {
+	s.desc = !s.desc
+}
+
+// sortByHeader sorts by the field shown in a header, reversing the direction if the
+// table is already sorted by it. It reports whether the header is sortable.
+func (s *tableSorter) sortByHeader(header string) bool {
+	for i, field := range s.fields {
+		if field.header == "" || field.header != header {
+			continue
+		}
+		if i == s.field {
+			s.reverse()
+		} else {
+			s.field = i
+			s.desc = field.desc
+		}
+		return true
+	}
+	return false
+}
+
+// handleKey applies the 'o' and 'O' sort keys, reporting whether the key was one of them
+func (s *tableSorter) handleKey(event *tcell.EventKey) bool {
+	if event.Key() != tcell.KeyRune {
+		return false
+	}
+
+	switch event.Rune() {
+	case 'o':
+		s.next()
+	case 'O':
+		s.reverse()
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *tableSorter) indicator() string {
+	if s.desc {
+		return "▼"
+	}
+	return "▲"
+}
+
+// status describes the sort for the footer
+func (s *tableSorter) status() string {
+	return fmt.Sprintf("sort: %s %s", s.name(), s.indicator())
+}
+
+// headerLabel returns the label of a header, marked if the table is sorted by it
+func (s *tableSorter) headerLabel(header string) string {
+	if s.fields[s.field].header == header {
+		return header + " " + s.indicator()
+	}
+	return header
+}
+
+// setHeaders writes the header row, marking the sorted column
+func (s *tableSorter) setHeaders(table *tview.Table, headers []string) {
+	for i, header := range headers {
+		cell := tview.NewTableCell(s.headerLabel(header)).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		table.SetCell(0, i, cell)
+	}
+}
+
+// sortRows sorts items in place by the current field. less compares two items by the
+// named field in ascending order; ties keep their order.
+func sortRows[T any](s *tableSorter, items []T, less func(field string, a, b T) bool) {
+	field := s.name()
+	sort.SliceStable(items, func(i, j int) bool {
+		if s.desc {
+			return less(field, items[j], items[i])
+		}
+		return less(field, items[i], items[j])
+	})
+}
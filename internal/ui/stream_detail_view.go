@@ -8,6 +8,7 @@ import (
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/shubhamrasal/n2s/internal/ui/components"
 )
 
@@ -17,17 +18,47 @@ type StreamDetailView struct {
 	flex        *tview.Flex
 	infoView    *tview.TextView
 	consumerTable *tview.Table
+	clusterTable  *tview.Table
 	streamName  string
 	stream      *models.Stream
-	consumers   []*models.Consumer
+	consumers   []*models.Consumer // Sorted copy of the cached consumers
+	sorter      *tableSorter
+
+	// Columns from the config, and those that fit the table's width
+	columns []tableColumn
+	shown   []tableColumn
+}
+
+// consumerColumns are the columns the consumer table offers
+var consumerColumns = []columnSpec{
+	{id: "name", header: "NAME", fit: 20},
+	{id: "pending", header: "PENDING", fit: 8},
+	{id: "ack_pending", header: "ACK PENDING", fit: 11},
+	{id: "redelivered", header: "REDELIVERED", fit: 11},
+	{id: "waiting", header: "WAITING", fit: 7},
+	{id: "last_delivered", header: "LAST DELIVERED", fit: 14},
+	{id: "filter", header: "FILTER", width: 30, fit: 20},
+	{id: "ack_policy", header: "ACK POLICY", fit: 10},
+	{id: "max_deliver", header: "MAX DELIVER", fit: 11},
 }
 
+var defaultConsumerColumns = []string{"name", "pending", "ack_pending", "redelivered"}
+
 // NewStreamDetailView creates a new stream detail view
 func NewStreamDetailView(ui *UIManager) *StreamDetailView {
 	view := &StreamDetailView{
 		ui:        ui,
 		consumers: make([]*models.Consumer, 0),
+		sorter: newTableSorter(
+			sortField{name: "name", header: "NAME"},
+			sortField{name: "pending", header: "PENDING", desc: true},
+			sortField{name: "ack pending", header: "ACK PENDING", desc: true},
+			sortField{name: "redelivered", header: "REDELIVERED", desc: true},
+			sortField{name: "last activity", header: "LAST DELIVERED", desc: true},
+		),
 	}
+	view.columns = ui.tableColumns("consumers", consumerColumns, defaultConsumerColumns)
+	view.shown = view.columns
 
 	// Info view
 	view.infoView = tview.NewTextView().
@@ -45,32 +76,51 @@ func NewStreamDetailView(ui *UIManager) *StreamDetailView {
 	view.consumerTable.SetBorder(true).
 		SetTitle(" Consumers ").
 		SetTitleAlign(tview.AlignCenter)
+	trackWidth(ui, view.consumerTable, view.onResize)
+
+	// Replica status, hidden when the stream is not clustered
+	view.clusterTable = newClusterTable()
 
 	// Layout
 	view.flex = tview.NewFlex().
 		SetDirection(tview.FlexRow).
 		AddItem(view.infoView, 7, 0, false).
+		AddItem(view.clusterTable, 0, 0, false).
 		AddItem(view.consumerTable, 0, 1, true)
 
 	view.setupKeybindings()
 	view.setupConsumerHeaders()
+	ui.cache.subscribe(view.onCacheChange)
 
 	return view
 }
 
 func (v *StreamDetailView) setupConsumerHeaders() {
-	headers := []string{"NAME", "PENDING", "ACK PENDING", "REDELIVERED"}
-	for i, header := range headers {
-		cell := tview.NewTableCell(header).
-			SetTextColor(tcell.ColorYellow).
-			SetAlign(tview.AlignLeft).
-			SetSelectable(false)
-		v.consumerTable.SetCell(0, i, cell)
+	v.sorter.setHeaders(v.consumerTable, columnHeaders(v.shown))
+}
+
+// onResize shows the configured columns that fit the new width
+func (v *StreamDetailView) onResize(width int) {
+	shown := fitColumns(v.columns, width)
+	if sameColumns(shown, v.shown) {
+		return
 	}
+
+	v.shown = shown
+	v.consumerTable.Clear()
+	v.setupConsumerHeaders()
+	v.updateConsumerTable()
 }
 
 func (v *StreamDetailView) setupKeybindings() {
 	v.consumerTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.setupConsumerHeaders()
+			v.sortConsumers()
+			v.updateConsumerTable()
+			return nil
+		}
+
 		switch event.Key() {
 		case tcell.KeyEnter:
 			v.onEnter()
@@ -87,11 +137,18 @@ func (v *StreamDetailView) setupKeybindings() {
 				v.deleteConsumer()
 				return nil
 			case 'r':
+				v.ui.cache.invalidate(v.streamName)
 				v.Refresh()
 				return nil
 			case 'm':
 				v.ui.ShowMessages(v.streamName)
 				return nil
+			case 'C':
+				v.ui.ShowConnections(v.streamName)
+				return nil
+			case 'L':
+				v.stepDownLeader()
+				return nil
 			case 'e':
 				// Check if on consumer row or stream
 				row, _ := v.consumerTable.GetSelection()
@@ -112,33 +169,71 @@ func (v *StreamDetailView) setupKeybindings() {
 // SetStream sets the stream to display
 func (v *StreamDetailView) SetStream(streamName string) {
 	v.streamName = streamName
+	v.stream = nil
 	v.flex.SetTitle(fmt.Sprintf(" Stream: %s ", streamName))
 	v.Refresh()
 }
 
-// Refresh updates the stream details and consumers
+// Refresh shows the cached stream and consumers right away and fetches them in the
+// background unless the cached ones are fresh
 func (v *StreamDetailView) Refresh() {
 	if v.streamName == "" {
 		return
 	}
 
-	// Get stream info
-	stream, err := v.ui.client.GetStreamInfo(v.streamName)
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to get stream info: %v", err))
+	v.render()
+
+	streamName := v.streamName
+	if _, consumersAt := v.ui.cache.consumerList(streamName); v.ui.cache.streamFresh(streamName) && v.ui.cache.fresh(consumersAt) {
 		return
 	}
-	v.stream = stream
 
-	// Get consumers
-	consumers, err := v.ui.client.ListConsumers(v.streamName)
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to list consumers: %v", err))
+	v.ui.refresh("stream-detail", func(client *nats.Client) func() {
+		// Get stream info
+		stream, err := client.GetStreamInfo(streamName)
+		if err != nil {
+			return func() { v.ui.ShowError(fmt.Sprintf("Failed to get stream info: %v", err)) }
+		}
+
+		// Get consumers
+		consumers, err := client.ListConsumers(streamName)
+		if err != nil {
+			return func() { v.ui.ShowError(fmt.Sprintf("Failed to list consumers: %v", err)) }
+		}
+
+		// The view is updated through onCacheChange
+		return func() {
+			v.ui.cache.putConsumers(streamName, consumers)
+			v.ui.cache.putStream(stream)
+		}
+	})
+}
+
+// onCacheChange re-renders when the shown stream's cached entries change
+func (v *StreamDetailView) onCacheChange(stream string) {
+	if v.ui.currentPage == "stream-detail" && (stream == "" || stream == v.streamName) {
+		v.render()
+	}
+}
+
+// render shows the cached stream and consumers
+func (v *StreamDetailView) render() {
+	stream, _ := v.ui.cache.stream(v.streamName)
+	consumers, _ := v.ui.cache.consumerList(v.streamName)
+	if stream == nil {
+		// Not fetched yet, or invalidated: keep what is shown until it is fetched again
+		if v.stream == nil {
+			v.infoView.SetText("[gray]Loading...[white]")
+		}
 		return
 	}
-	v.consumers = consumers
+
+	v.stream = stream
+	v.consumers = append([]*models.Consumer(nil), consumers...)
+	v.sortConsumers()
 
 	v.updateInfo()
+	v.flex.ResizeItem(v.clusterTable, updateClusterTable(v.clusterTable, v.stream.Cluster), 0)
 	v.updateConsumerTable()
 }
 
@@ -165,9 +260,37 @@ func (v *StreamDetailView) updateInfo() {
 		formatBytes(uint64(v.stream.Config.MaxBytes)),
 	)
 
+	// One-line replication summary; details are in the describe and topology views
+	var upstreams []string
+	if m := v.stream.Config.Mirror; m != nil {
+		upstreams = append(upstreams, fmt.Sprintf("mirror of %s (%s)", upstreamNode(m), sourceStatus(m)))
+	}
+	for _, s := range v.stream.Config.Sources {
+		upstreams = append(upstreams, fmt.Sprintf("%s (%s)", upstreamNode(s), sourceStatus(s)))
+	}
+	if len(upstreams) > 0 {
+		info += "\n[yellow]Replication:[white] " + strings.Join(upstreams, ", ")
+	}
+
 	v.infoView.SetText(info)
 }
 
+func (v *StreamDetailView) sortConsumers() {
+	sortRows(v.sorter, v.consumers, func(field string, a, b *models.Consumer) bool {
+		switch field {
+		case "pending":
+			return a.NumPending < b.NumPending
+		case "ack pending":
+			return a.NumAckPending < b.NumAckPending
+		case "redelivered":
+			return a.NumRedelivered < b.NumRedelivered
+		case "last activity":
+			return a.Delivered.Last.Before(b.Delivered.Last)
+		}
+		return a.Name < b.Name
+	})
+}
+
 func (v *StreamDetailView) updateConsumerTable() {
 	// Clear existing rows (keep header)
 	for row := v.consumerTable.GetRowCount() - 1; row > 0; row-- {
@@ -176,15 +299,37 @@ func (v *StreamDetailView) updateConsumerTable() {
 
 	// Add consumer rows
 	for i, consumer := range v.consumers {
-		row := i + 1
-		
-		v.consumerTable.SetCell(row, 0, tview.NewTableCell(consumer.Name))
-		v.consumerTable.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d", consumer.NumPending)))
-		v.consumerTable.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%d", consumer.NumAckPending)))
-		v.consumerTable.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("%d", consumer.NumRedelivered)))
+		for column, spec := range v.shown {
+			setCellText(v.consumerTable, i+1, column, consumerColumnText(spec.id, consumer), spec.maxWidth)
+		}
 	}
 
-	v.ui.footer.Update("Enter: Consumer  d: Describe  e: Edit  m: Messages  x: Delete  r: Refresh  Esc: Back")
+	v.ui.footer.Update(fmt.Sprintf("Enter: Consumer  C: Connections  d: Describe  e: Edit  L: Leader Step-down  m: Messages  o/O: Sort  x: Delete  r: Refresh  Esc: Back  [%s]", v.sorter.status()))
+}
+
+// consumerColumnText formats a consumer's value for a column
+func consumerColumnText(id string, consumer *models.Consumer) string {
+	switch id {
+	case "name":
+		return consumer.Name
+	case "pending":
+		return fmt.Sprintf("%d", consumer.NumPending)
+	case "ack_pending":
+		return fmt.Sprintf("%d", consumer.NumAckPending)
+	case "redelivered":
+		return fmt.Sprintf("%d", consumer.NumRedelivered)
+	case "waiting":
+		return fmt.Sprintf("%d", consumer.NumWaiting)
+	case "last_delivered":
+		return formatOptionalTime(consumer.Delivered.Last)
+	case "filter":
+		return consumer.Config.FilterSubject
+	case "ack_policy":
+		return consumer.Config.AckPolicy
+	case "max_deliver":
+		return fmt.Sprintf("%d", consumer.Config.MaxDeliver)
+	}
+	return ""
 }
 
 func (v *StreamDetailView) onEnter() {
@@ -220,6 +365,7 @@ func (v *StreamDetailView) deleteConsumer() {
 				if err := v.ui.client.DeleteConsumer(v.streamName, consumer.Name); err != nil {
 					v.ui.ShowError(fmt.Sprintf("Failed to delete: %v", err))
 				} else {
+					v.ui.cache.invalidate(v.streamName)
 					v.Refresh()
 				}
 			},
@@ -232,6 +378,36 @@ func (v *StreamDetailView) deleteConsumer() {
 	}
 }
 
+func (v *StreamDetailView) stepDownLeader() {
+	if v.ui.readOnly {
+		v.ui.ShowError("Cannot step down leader in read-only mode")
+		return
+	}
+
+	if v.stream == nil || v.stream.Cluster == nil {
+		v.ui.ShowError("Stream is not clustered")
+		return
+	}
+
+	modal := components.ConfirmModal(
+		fmt.Sprintf("Step down leader '%s' of stream '%s'?\nA new leader will be elected.", v.stream.Cluster.Leader, v.streamName),
+		func() {
+			v.ui.CloseModal()
+			if err := v.ui.client.StepDownStreamLeader(v.streamName); err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to step down leader: %v", err))
+			} else {
+				v.ui.cache.invalidate(v.streamName)
+				v.Refresh()
+			}
+		},
+		func() {
+			v.ui.CloseModal()
+		},
+	)
+
+	v.ui.ShowModal(modal)
+}
+
 // GetPrimitive returns the primitive for this view
 func (v *StreamDetailView) GetPrimitive() tview.Primitive {
 	return v.flex
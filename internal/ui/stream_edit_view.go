@@ -193,8 +193,16 @@ func (v *StreamEditView) previewChanges() {
 	diff.WriteString(fmt.Sprintf("Stream: [cyan]%s[white]\n\n", v.streamName))
 	
 	// Compare old vs new
-	hasChanges := false
+	if !writeStreamDiff(&diff, v.currentStream.Config, v.patch()) {
+		diff.WriteString("[gray]No changes detected[white]")
+	}
 	
+	v.diffView.SetText(diff.String())
+	v.diffView.ScrollToBeginning()
+}
+
+// patch returns the settings in the form
+func (v *StreamEditView) patch() models.StreamPatch {
 	// Parse new values
 	var newMaxMsgs int64
 	if strings.ToLower(v.maxMsgs) == "unlimited" {
@@ -203,23 +211,6 @@ func (v *StreamEditView) previewChanges() {
 		newMaxMsgs, _ = strconv.ParseInt(v.maxMsgs, 10, 64)
 	}
 	
-	// Max Messages
-	if newMaxMsgs != v.currentStream.Config.MaxMessages {
-		diff.WriteString(fmt.Sprintf("Max Messages:\n"))
-		if v.currentStream.Config.MaxMessages == -1 {
-			diff.WriteString(fmt.Sprintf("  [red]- unlimited[white]\n"))
-		} else {
-			diff.WriteString(fmt.Sprintf("  [red]- %d[white]\n", v.currentStream.Config.MaxMessages))
-		}
-		if newMaxMsgs == -1 {
-			diff.WriteString(fmt.Sprintf("  [green]+ unlimited[white]\n\n"))
-		} else {
-			diff.WriteString(fmt.Sprintf("  [green]+ %d[white]\n\n", newMaxMsgs))
-		}
-		hasChanges = true
-	}
-	
-	// Max Bytes
 	var newMaxBytes int64
 	if strings.ToLower(v.maxBytes) == "unlimited" {
 		newMaxBytes = -1
@@ -227,14 +218,6 @@ func (v *StreamEditView) previewChanges() {
 		newMaxBytes = int64(parseByteString(v.maxBytes))
 	}
 	
-	if newMaxBytes != v.currentStream.Config.MaxBytes {
-		diff.WriteString(fmt.Sprintf("Max Bytes:\n"))
-		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", formatBytes(uint64(v.currentStream.Config.MaxBytes))))
-		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", formatBytes(uint64(newMaxBytes))))
-		hasChanges = true
-	}
-	
-	// Max Age
 	var newMaxAge time.Duration
 	if strings.ToLower(v.maxAge) == "unlimited" {
 		newMaxAge = 0
@@ -242,14 +225,6 @@ func (v *StreamEditView) previewChanges() {
 		newMaxAge, _ = time.ParseDuration(v.maxAge)
 	}
 	
-	if newMaxAge != v.currentStream.Config.MaxAge {
-		diff.WriteString(fmt.Sprintf("Max Age:\n"))
-		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", v.currentStream.Config.MaxAge))
-		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", newMaxAge))
-		hasChanges = true
-	}
-	
-	// Max Message Size
 	var newMaxMsgSize int32
 	if strings.ToLower(v.maxMsgSize) == "unlimited" {
 		newMaxMsgSize = -1
@@ -257,35 +232,72 @@ func (v *StreamEditView) previewChanges() {
 		newMaxMsgSize = int32(parseByteString(v.maxMsgSize))
 	}
 	
-	if newMaxMsgSize != v.currentStream.Config.MaxMsgSize {
-		diff.WriteString(fmt.Sprintf("Max Message Size:\n"))
-		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", formatBytes(uint64(v.currentStream.Config.MaxMsgSize))))
-		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", formatBytes(uint64(newMaxMsgSize))))
+	return models.StreamPatch{
+		MaxMessages: &newMaxMsgs,
+		MaxBytes:    &newMaxBytes,
+		MaxAge:      &newMaxAge,
+		MaxMsgSize:  &newMaxMsgSize,
+		Retention:   v.retention,
+		Discard:     v.discard,
+	}
+}
+
+// writeStreamDiff writes the settings a patch changes in a stream's configuration as
+// removed and added lines. It reports whether anything changes.
+func writeStreamDiff(diff *strings.Builder, current models.StreamConfig, patch models.StreamPatch) bool {
+	patched := patch.Apply(current)
+	hasChanges := false
+	
+	change := func(name, from, to string) {
+		diff.WriteString(fmt.Sprintf("%s:\n", name))
+		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", from))
+		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", to))
 		hasChanges = true
 	}
 	
+	// Max Messages
+	if patched.MaxMessages != current.MaxMessages {
+		change("Max Messages", formatMsgLimit(current.MaxMessages), formatMsgLimit(patched.MaxMessages))
+	}
+	
+	// Max Bytes
+	if patched.MaxBytes != current.MaxBytes {
+		change("Max Bytes", formatBytes(uint64(current.MaxBytes)), formatBytes(uint64(patched.MaxBytes)))
+	}
+	
+	// Max Age
+	if patched.MaxAge != current.MaxAge {
+		change("Max Age", current.MaxAge.String(), patched.MaxAge.String())
+	}
+	
+	// Max Message Size
+	if patched.MaxMsgSize != current.MaxMsgSize {
+		change("Max Message Size", formatBytes(uint64(current.MaxMsgSize)), formatBytes(uint64(patched.MaxMsgSize)))
+	}
+	
+	// Replicas
+	if patched.Replicas != current.Replicas {
+		change("Replicas", fmt.Sprintf("%d", current.Replicas), fmt.Sprintf("%d", patched.Replicas))
+	}
+	
 	// Retention (case-insensitive comparison)
-	if !strings.EqualFold(v.retention, v.currentStream.Config.Retention) {
-		diff.WriteString(fmt.Sprintf("Retention:\n"))
-		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", v.currentStream.Config.Retention))
-		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", v.retention))
-		hasChanges = true
+	if !strings.EqualFold(patched.Retention, current.Retention) {
+		change("Retention", current.Retention, patched.Retention)
 	}
 	
 	// Discard (case-insensitive comparison)
-	if !strings.EqualFold(v.discard, v.currentStream.Config.Discard) {
-		diff.WriteString(fmt.Sprintf("Discard:\n"))
-		diff.WriteString(fmt.Sprintf("  [red]- %s[white]\n", v.currentStream.Config.Discard))
-		diff.WriteString(fmt.Sprintf("  [green]+ %s[white]\n\n", v.discard))
-		hasChanges = true
+	if !strings.EqualFold(patched.Discard, current.Discard) {
+		change("Discard", current.Discard, patched.Discard)
 	}
 	
-	if !hasChanges {
-		diff.WriteString("[gray]No changes detected[white]")
+	return hasChanges
+}
+
+func formatMsgLimit(limit int64) string {
+	if limit == -1 {
+		return "unlimited"
 	}
-	
-	v.diffView.SetText(diff.String())
-	v.diffView.ScrollToBeginning()
+	return fmt.Sprintf("%d", limit)
 }
 
 func (v *StreamEditView) applyChanges() {
@@ -310,42 +322,14 @@ func (v *StreamEditView) applyChanges() {
 }
 
 func (v *StreamEditView) performUpdate() {
-	// Parse new values
-	var newMaxMsgs int64
-	if strings.ToLower(v.maxMsgs) == "unlimited" {
-		newMaxMsgs = -1
-	} else {
-		newMaxMsgs, _ = strconv.ParseInt(v.maxMsgs, 10, 64)
-	}
-	
-	var newMaxBytes int64
-	if strings.ToLower(v.maxBytes) == "unlimited" {
-		newMaxBytes = -1
-	} else {
-		newMaxBytes = int64(parseByteString(v.maxBytes))
-	}
-	
-	var newMaxAge time.Duration
-	if strings.ToLower(v.maxAge) == "unlimited" {
-		newMaxAge = 0
-	} else {
-		newMaxAge, _ = time.ParseDuration(v.maxAge)
-	}
-	
-	var newMaxMsgSize int32
-	if strings.ToLower(v.maxMsgSize) == "unlimited" {
-		newMaxMsgSize = -1
-	} else {
-		newMaxMsgSize = int32(parseByteString(v.maxMsgSize))
-	}
-	
 	// Update stream via NATS
-	err := v.ui.client.UpdateStream(v.streamName, newMaxMsgs, newMaxBytes, newMaxAge, newMaxMsgSize, v.retention, v.discard)
+	err := v.ui.client.PatchStream(v.streamName, v.patch())
 	
 	if err != nil {
 		v.ui.ShowError(fmt.Sprintf("Failed to update stream: %v", err))
 		return
 	}
+	v.ui.cache.invalidate(v.streamName)
 	
 	// Show success and go back
 	modal := components.InfoModal("Stream Updated",
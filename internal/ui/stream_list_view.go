@@ -1,12 +1,17 @@
 package ui
 
 import (
+	"context"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/filter"
 	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/shubhamrasal/n2s/internal/ui/components"
 )
 
@@ -18,19 +23,75 @@ type StreamListView struct {
 	table         *tview.Table
 	describePanel *tview.TextView
 	searchInput   *tview.InputField
-	streams       []*models.Stream
-	allStreams    []*models.Stream
+	names         []string        // Streams shown, after the name filter
+	allNames      []string        // Streams listed by the server, after the subject filter
+	loading       map[string]bool // Info requests in flight
 	filterText    string
+	filter        *filter.Expr // Last valid filter expression
 	searching     bool
+	sorter        *tableSorter
+	unsorted      int // Rows not sorted yet because their info is still loading
+
+	// Columns from the config, and those that fit the table's width
+	columns []tableColumn
+	shown   []tableColumn
+	width   int
+
+	rates map[string]float64 // Messages per second, from the last sequence of consecutive fetches
+
+	// Listing state for the adaptive refresh interval
+	subjectFilter string        // Server-side subject filter the names were listed with
+	listedAt      time.Time     // When the last listing started
+	listingCost   time.Duration // How long the last names listing took
+	infoCost      time.Duration // How long the last info fetch for visible rows took
+}
+
+// streamColumns are the columns the stream table offers
+var streamColumns = []columnSpec{
+	{id: "name", header: "NAME", fit: 20},
+	{id: "subjects", header: "SUBJECTS", width: 30, fit: 30},
+	{id: "msgs", header: "MSGS", fit: 8},
+	{id: "bytes", header: "BYTES", fit: 8},
+	{id: "consumers", header: "CONSUMERS", fit: 9},
+	{id: "storage", header: "STORAGE", fit: 7},
+	{id: "replicas", header: "REPLICAS", fit: 8},
+	{id: "retention", header: "RETENTION", fit: 9},
+	{id: "first", header: "FIRST MSG", fit: 10},
+	{id: "last", header: "LAST MSG", fit: 10},
+	{id: "age", header: "AGE", fit: 5},
+	{id: "deleted", header: "DELETED", fit: 7},
+	{id: "usage", header: "USAGE", fit: 5},
+	{id: "rate", header: "RATE", fit: 8},
+	{id: "lag", header: "LAG", fit: 8},
 }
 
+var defaultStreamColumns = []string{"name", "subjects", "msgs", "bytes", "consumers"}
+
+// Stream info is only fetched for the visible rows plus this many rows below them
+const streamPrefetchRows = 20
+
+// The refresh interval backs off to this multiple of the listing cost, up to maxStreamListInterval
+const (
+	streamListBackoff     = 10
+	maxStreamListInterval = 30 * time.Second
+)
+
 // NewStreamListView creates a new stream list view
 func NewStreamListView(ui *UIManager) *StreamListView {
 	view := &StreamListView{
-		ui:         ui,
-		streams:    make([]*models.Stream, 0),
-		allStreams: make([]*models.Stream, 0),
+		ui:      ui,
+		loading: make(map[string]bool),
+		rates:   make(map[string]float64),
+		sorter: newTableSorter(
+			sortField{name: "name", header: "NAME"},
+			sortField{name: "msgs", header: "MSGS", desc: true},
+			sortField{name: "bytes", header: "BYTES", desc: true},
+			sortField{name: "consumers", header: "CONSUMERS", desc: true},
+			sortField{name: "last activity", header: "LAST MSG", desc: true},
+		),
 	}
+	view.columns = ui.tableColumns("streams", streamColumns, defaultStreamColumns)
+	view.shown = view.columns
 
 	view.table = tview.NewTable().
 		SetBorders(false).
@@ -39,11 +100,14 @@ func NewStreamListView(ui *UIManager) *StreamListView {
 		SetSelectionChangedFunc(func(row, column int) {
 			// Update describe panel when selection changes
 			view.updateDescribePanel(row)
+			// Scrolling may reveal rows without info
+			view.loadVisible()
 		})
 
 	view.table.SetBorder(true).
 		SetTitle(" Streams ").
 		SetTitleAlign(tview.AlignCenter)
+	trackWidth(ui, view.table, view.onResize)
 
 	// Search input field
 	view.searchInput = tview.NewInputField().
@@ -51,6 +115,18 @@ func NewStreamListView(ui *UIManager) *StreamListView {
 		SetFieldWidth(50).
 		SetChangedFunc(func(text string) {
 			view.filterText = text
+			expr, err := filter.Parse(text)
+			if err != nil {
+				// Keep the last valid filter while the expression is being typed
+				view.searchInput.SetTitle(fmt.Sprintf(" Search - [red]%v[-] ", err))
+				return
+			}
+			view.searchInput.SetTitle(" Search (ESC to clear) ")
+			view.filter = expr
+			if view.listingSubject() != view.subjectFilter {
+				view.Refresh()
+				return
+			}
 			view.applyFilter()
 		})
 
@@ -81,23 +157,48 @@ func NewStreamListView(ui *UIManager) *StreamListView {
 
 	view.setupKeybindings()
 	view.setupHeaders()
+	ui.cache.subscribe(view.onCacheChange)
 
 	return view
 }
 
 func (v *StreamListView) setupHeaders() {
-	headers := []string{"NAME", "SUBJECTS", "MSGS", "BYTES", "CONSUMERS"}
-	for i, header := range headers {
-		cell := tview.NewTableCell(header).
-			SetTextColor(tcell.ColorYellow).
-			SetAlign(tview.AlignLeft).
-			SetSelectable(false)
-		v.table.SetCell(0, i, cell)
+	v.sorter.setHeaders(v.table, columnHeaders(v.shown))
+}
+
+// onResize shows the configured columns that fit the new width
+func (v *StreamListView) onResize(width int) {
+	v.width = width
+	shown := fitColumns(v.columns, width)
+	if sameColumns(shown, v.shown) {
+		return
+	}
+
+	v.shown = shown
+	v.table.Clear()
+	v.setupHeaders()
+	v.updateTable()
+}
+
+// showsColumn reports whether a column is on screen
+func (v *StreamListView) showsColumn(id string) bool {
+	for _, column := range v.shown {
+		if column.id == id {
+			return true
+		}
 	}
+	return false
 }
 
 func (v *StreamListView) setupKeybindings() {
 	v.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.sorter.handleKey(event) {
+			v.setupHeaders()
+			v.updateTable()
+			v.loadVisible()
+			return nil
+		}
+
 		switch event.Key() {
 		case tcell.KeyEnter:
 			v.onEnter()
@@ -121,10 +222,8 @@ func (v *StreamListView) setupKeybindings() {
 				return nil
 			case 'd':
 				// Show full-screen describe view
-				row, _ := v.table.GetSelection()
-				if row > 0 && row <= len(v.streams) {
-					stream := v.streams[row-1]
-					v.ui.ShowDescribe(stream.Name)
+				if name := v.selectedName(); name != "" {
+					v.ui.ShowDescribe(name)
 				}
 				return nil
 			case 'x':
@@ -145,6 +244,27 @@ func (v *StreamListView) setupKeybindings() {
 			case 'e':
 				v.editStream()
 				return nil
+			case 'E':
+				v.ui.ShowEvents()
+				return nil
+			case 'a':
+				v.ui.ShowAccount()
+				return nil
+			case 'S':
+				v.ui.ShowServers()
+				return nil
+			case 'C':
+				v.ui.ShowConnections("")
+				return nil
+			case 'T':
+				v.ui.ShowTopology()
+				return nil
+			case 'l':
+				v.ui.ShowConnectionLog()
+				return nil
+			case 'A':
+				v.ui.ShowAudit()
+				return nil
 			}
 		}
 		return event
@@ -164,17 +284,214 @@ func (v *StreamListView) setupKeybindings() {
 	})
 }
 
-// Refresh updates the stream list
+// Refresh lists the stream names and fetches info for the visible rows. Names are
+// listed server-side with the subject filter, if one is set.
 func (v *StreamListView) Refresh() {
-	// Fetch streams from NATS
-	streams, err := v.ui.client.ListStreams()
-	if err != nil {
-		v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+	subject := v.listingSubject()
+	v.subjectFilter = subject
+	v.listedAt = time.Now()
+
+	v.ui.refresh("streams", func(client *nats.Client) func() {
+		start := time.Now()
+		names, err := client.ListStreamNames(subject)
+		cost := time.Since(start)
+
+		return func() {
+			if err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+				return
+			}
+
+			v.allNames = names
+			v.listingCost = cost
+
+			// Forget streams that are gone, unless only some were listed
+			if subject == "" {
+				listed := make(map[string]bool, len(names))
+				for _, name := range names {
+					listed[name] = true
+				}
+				v.ui.cache.retainStreams(listed)
+			}
+
+			v.applyFilter()
+		}
+	})
+}
+
+// listingSubject returns the server-side subject filter from a "subject=<subject>" term
+// of the filter expression
+func (v *StreamListView) listingSubject() string {
+	return v.filter.ListingSubject()
+}
+
+// refreshDue reports whether the auto-refresh should list streams again. The interval
+// backs off when listing is expensive so large accounts aren't listed every tick.
+func (v *StreamListView) refreshDue() bool {
+	return time.Since(v.listedAt) >= v.refreshInterval()
+}
+
+func (v *StreamListView) refreshInterval() time.Duration {
+	interval := v.ui.config.GetRefreshInterval()
+	if backoff := (v.listingCost + v.infoCost) * streamListBackoff; backoff > interval {
+		interval = min(backoff, maxStreamListInterval)
+	}
+	return interval
+}
+
+// loadVisible fetches info in the background for visible rows that have none or whose
+// info predates the last listing
+func (v *StreamListView) loadVisible() {
+	first, last := v.visibleRange()
+
+	// The lag column and filter need the consumers of each stream
+	withConsumers := v.showsColumn("lag") || v.filter.NeedsConsumers()
+
+	var names []string
+	for _, name := range v.names[first:last] {
+		if v.loading[name] {
+			continue
+		}
+		if _, consumersAt := v.ui.cache.consumerList(name); withConsumers && consumersAt.IsZero() {
+			names = append(names, name)
+			continue
+		}
+		// Info fetched since the listing, or recently by another view, is current
+		if stream, fetchedAt := v.ui.cache.stream(name); stream != nil && (fetchedAt.After(v.listedAt) || v.ui.cache.fresh(fetchedAt)) {
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
 		return
 	}
 
-	v.allStreams = streams
-	v.applyFilter()
+	for _, name := range names {
+		v.loading[name] = true
+	}
+
+	client := v.ui.client
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+		defer cancel()
+
+		start := time.Now()
+		streams, err := client.WithContext(ctx).GetStreamInfos(names)
+		var consumers map[string][]*models.Consumer
+		if err == nil && withConsumers {
+			consumers, err = client.WithContext(ctx).ListConsumersOf(names)
+		}
+		cost := time.Since(start)
+
+		v.ui.app.QueueUpdateDraw(func() {
+			for _, name := range names {
+				delete(v.loading, name)
+			}
+			if client != v.ui.client {
+				// Context switched while loading
+				return
+			}
+
+			// Streams deleted since the listing aren't returned; drop them so
+			// they aren't requested again
+			if err == nil {
+				v.dropMissing(names, streams)
+			}
+
+			// The table is updated through onCacheChange
+			v.infoCost = cost
+			v.updateRates(streams)
+			if consumers != nil {
+				v.ui.cache.putConsumerLists(consumers)
+			}
+			v.ui.cache.putStreams(streams)
+
+			selected := v.selectedName()
+			for _, stream := range streams {
+				if stream.Name == selected {
+					row, _ := v.table.GetSelection()
+					v.updateDescribePanel(row)
+				}
+			}
+
+			if err != nil && v.ui.currentPage == "streams" {
+				v.ui.ShowError(fmt.Sprintf("Failed to get stream info: %v", err))
+			}
+		})
+	}()
+}
+
+// dropMissing removes requested streams that weren't returned from the list
+func (v *StreamListView) dropMissing(requested []string, streams []*models.Stream) {
+	returned := make(map[string]bool, len(streams))
+	for _, stream := range streams {
+		returned[stream.Name] = true
+	}
+	missing := func(name string) bool {
+		return !returned[name] && slices.Contains(requested, name)
+	}
+
+	v.allNames = slices.DeleteFunc(v.allNames, missing)
+	v.names = slices.DeleteFunc(v.names, missing)
+}
+
+// updateRates computes the message rate of streams from the last sequence of their
+// previously cached info. Fetches less than a second apart keep the previous rate.
+func (v *StreamListView) updateRates(streams []*models.Stream) {
+	for _, stream := range streams {
+		previous, fetchedAt := v.ui.cache.stream(stream.Name)
+		if previous == nil || stream.State.LastSeq < previous.State.LastSeq {
+			continue
+		}
+		if elapsed := time.Since(fetchedAt); elapsed >= time.Second {
+			v.rates[stream.Name] = float64(stream.State.LastSeq-previous.State.LastSeq) / elapsed.Seconds()
+		}
+	}
+}
+
+// onCacheChange updates the rows when cached streams change. A dropped entry means the
+// stream changed on the server, so its info is fetched again and the names relisted.
+func (v *StreamListView) onCacheChange(stream string) {
+	invalidated := false
+	if stream != "" {
+		if cached, _ := v.ui.cache.stream(stream); cached == nil {
+			invalidated = true
+			v.listedAt = time.Time{}
+		}
+	}
+
+	if v.ui.currentPage != "streams" {
+		return
+	}
+	if v.filter.NeedsInfo() {
+		// Whether streams match may have changed
+		v.applyFilter()
+		return
+	}
+	v.updateTable()
+	if invalidated {
+		v.loadVisible()
+	}
+}
+
+// visibleRange returns the indexes into names of the rows on screen plus the prefetched rows
+func (v *StreamListView) visibleRange() (int, int) {
+	// Sorting by anything but the name, or filtering by other fields, needs the info
+	// of every stream
+	if v.sorter.name() != "name" || v.filter.NeedsInfo() {
+		return 0, len(v.names)
+	}
+
+	offset, _ := v.table.GetOffset()
+	_, _, _, height := v.table.GetInnerRect()
+	if height <= 1 {
+		// Not drawn yet
+		height = 50
+	}
+
+	first := min(offset, len(v.names))
+	last := min(first+height-1+streamPrefetchRows, len(v.names))
+	return first, last
 }
 
 func (v *StreamListView) showSearch() {
@@ -192,7 +509,9 @@ func (v *StreamListView) showSearch() {
 func (v *StreamListView) clearSearch() {
 	v.searching = false
 	v.filterText = ""
+	v.filter = nil
 	v.searchInput.SetText("")
+	v.searchInput.SetTitle(" Search (ESC to clear) ")
 	v.leftFlex.Clear()
 	v.leftFlex.AddItem(v.table, 0, 1, true)
 	v.ui.app.SetFocus(v.table)
@@ -207,88 +526,216 @@ func (v *StreamListView) closeSearchKeepFilter() {
 	v.updateFooter()
 }
 
+// applyFilter selects the listed streams matching the filter expression. Streams
+// whose info is needed but not fetched yet are kept until it arrives.
 func (v *StreamListView) applyFilter() {
-	if v.filterText == "" {
-		v.streams = v.allStreams
-	} else {
-		v.streams = make([]*models.Stream, 0)
-		filterLower := strings.ToLower(v.filterText)
-		for _, stream := range v.allStreams {
-			if strings.Contains(strings.ToLower(stream.Name), filterLower) {
-				v.streams = append(v.streams, stream)
+	needsInfo, needsConsumers := v.filter.NeedsInfo(), v.filter.NeedsConsumers()
+	v.names = make([]string, 0, len(v.allNames))
+	for _, name := range v.allNames {
+		stream, _ := v.ui.cache.stream(name)
+		consumers, consumersAt := v.ui.cache.consumerList(name)
+		if stream == nil || needsConsumers && consumersAt.IsZero() {
+			if needsInfo {
+				v.names = append(v.names, name)
+				continue
 			}
+			stream = &models.Stream{Name: name}
+		}
+		if v.filter.Match(stream, consumers) {
+			v.names = append(v.names, name)
 		}
 	}
 	v.updateTable()
+	v.loadVisible()
 }
 
+// updateTable updates the rows in place, only touching cells whose text changed
 func (v *StreamListView) updateTable() {
-	// Clear existing rows (keep header)
-	for row := v.table.GetRowCount() - 1; row > 0; row-- {
+	v.sortNames()
+
+	// Remove rows beyond the list (keep header)
+	for row := v.table.GetRowCount() - 1; row > len(v.names); row-- {
 		v.table.RemoveRow(row)
 	}
 
-	// Add stream rows
-	for i, stream := range v.streams {
-		row := i + 1
-
-		// Format subjects
-		subjects := fmt.Sprintf("%v", stream.Subjects)
-		if len(subjects) > 30 {
-			subjects = subjects[:27] + "..."
+	// Rows without info yet show placeholders
+	for i, name := range v.names {
+		stream, _ := v.ui.cache.stream(name)
+		for column, spec := range v.shown {
+			text := "…"
+			switch {
+			case spec.id == "name":
+				text = name
+			case stream != nil:
+				text = v.columnText(spec.id, stream)
+			}
+			setCellText(v.table, i+1, column, text, spec.maxWidth)
 		}
+	}
 
-		v.table.SetCell(row, 0, tview.NewTableCell(stream.Name))
-		v.table.SetCell(row, 1, tview.NewTableCell(subjects))
-		v.table.SetCell(row, 2, tview.NewTableCell(formatNumber(stream.Messages)))
-		v.table.SetCell(row, 3, tview.NewTableCell(formatBytes(stream.Bytes)))
-		v.table.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%d", stream.Consumers)))
+	title := fmt.Sprintf(" Streams (%d) ", len(v.allNames))
+	if interval := v.refreshInterval(); interval > v.ui.config.GetRefreshInterval() {
+		title = fmt.Sprintf(" Streams (%d) - refresh every %s ", len(v.allNames), interval.Round(time.Second))
 	}
+	v.table.SetTitle(title)
 
 	v.updateFooter()
 }
 
+// columnText formats a stream's value for a column
+func (v *StreamListView) columnText(id string, stream *models.Stream) string {
+	switch id {
+	case "subjects":
+		return fmt.Sprintf("%v", stream.Subjects)
+	case "msgs":
+		return formatNumber(stream.Messages)
+	case "bytes":
+		return formatBytes(stream.Bytes)
+	case "consumers":
+		return fmt.Sprintf("%d", stream.Consumers)
+	case "storage":
+		return stream.Config.Storage
+	case "replicas":
+		return fmt.Sprintf("%d", stream.Config.Replicas)
+	case "retention":
+		return stream.Config.Retention
+	case "first":
+		return formatOptionalTime(stream.State.FirstTime)
+	case "last":
+		return formatOptionalTime(stream.State.LastTime)
+	case "age":
+		if stream.Created.IsZero() {
+			return "-"
+		}
+		return formatAge(time.Since(stream.Created))
+	case "deleted":
+		return formatNumber(stream.State.NumDeleted)
+	case "usage":
+		if usage, ok := streamUsage(stream); ok {
+			return fmt.Sprintf("%.0f%%", usage)
+		}
+		return "-"
+	case "rate":
+		if rate, ok := v.rates[stream.Name]; ok {
+			return fmt.Sprintf("%.1f/s", rate)
+		}
+		return "…"
+	case "lag":
+		consumers, fetchedAt := v.ui.cache.consumerList(stream.Name)
+		if fetchedAt.IsZero() {
+			return "…"
+		}
+		var lag uint64
+		for _, consumer := range consumers {
+			lag = max(lag, consumer.NumPending)
+		}
+		return formatNumber(lag)
+	}
+	return ""
+}
+
+// streamUsage returns how much of its message or byte limit a stream uses, in percent,
+// whichever is higher. It reports false for streams without either limit.
+func streamUsage(stream *models.Stream) (float64, bool) {
+	usage, limited := 0.0, false
+	if stream.Config.MaxMessages > 0 {
+		usage = float64(stream.State.Messages) / float64(stream.Config.MaxMessages) * 100
+		limited = true
+	}
+	if stream.Config.MaxBytes > 0 {
+		usage = max(usage, float64(stream.State.Bytes)/float64(stream.Config.MaxBytes)*100)
+		limited = true
+	}
+	return usage, limited
+}
+
+// sortNames orders the rows by the sort field. Streams whose info hasn't been fetched
+// yet can only be sorted by name, so they go last until loadVisible has fetched them.
+func (v *StreamListView) sortNames() {
+	v.unsorted = 0
+	if v.sorter.name() == "name" {
+		sortRows(v.sorter, v.names, func(_ string, a, b string) bool { return a < b })
+		return
+	}
+
+	loaded := make([]*models.Stream, 0, len(v.names))
+	var pending []string
+	for _, name := range v.names {
+		if stream, _ := v.ui.cache.stream(name); stream != nil {
+			loaded = append(loaded, stream)
+		} else {
+			pending = append(pending, name)
+		}
+	}
+
+	sortRows(v.sorter, loaded, func(field string, a, b *models.Stream) bool {
+		switch field {
+		case "msgs":
+			return a.State.Messages < b.State.Messages
+		case "bytes":
+			return a.State.Bytes < b.State.Bytes
+		case "consumers":
+			return a.Consumers < b.Consumers
+		case "last activity":
+			return a.State.LastTime.Before(b.State.LastTime)
+		}
+		return a.Name < b.Name
+	})
+
+	v.names = v.names[:0]
+	for _, stream := range loaded {
+		v.names = append(v.names, stream.Name)
+	}
+	v.names = append(v.names, pending...)
+	v.unsorted = len(pending)
+}
+
 func (v *StreamListView) updateFooter() {
 	if v.searching {
-		v.ui.footer.Update("Type to filter  Tab/Enter: Jump to list  ESC: Clear filter")
+		v.ui.footer.Update("Filter by name or fields, e.g. msgs>1e6 AND storage=memory (subject=<subject> filters on the server)  Tab/Enter: Jump to list  ESC: Clear filter")
 	} else {
 		filterInfo := ""
 		if v.filterText != "" {
-			filterInfo = fmt.Sprintf(" [Filtered: %d/%d]", len(v.streams), len(v.allStreams))
+			filterInfo = fmt.Sprintf(" [Filtered: %d]", len(v.names))
+		}
+		sortInfo := v.sorter.status()
+		if v.unsorted > 0 {
+			sortInfo += fmt.Sprintf(", partial: %d loading", v.unsorted)
 		}
-		v.ui.footer.Update(fmt.Sprintf("Enter: Details  b: Bulk  d: Describe  e: Edit  g: Graphs  m: Messages  x: Delete%s", filterInfo))
+		v.ui.footer.Update(fmt.Sprintf("Enter: Details  a: Account  A: Audit  b: Bulk  C: Connections  d: Describe  e: Edit  E: Events  g: Graphs  l: Conn Log  m: Messages  o/O: Sort  S: Servers  T: Topology  x: Delete  [%s]%s", sortInfo, filterInfo))
 	}
 }
 
-func (v *StreamListView) onEnter() {
+// selectedName returns the name of the selected stream, or "" if none is selected
+func (v *StreamListView) selectedName() string {
 	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-		v.ui.ShowStreamDetail(stream.Name)
+	if row > 0 && row <= len(v.names) {
+		return v.names[row-1]
+	}
+	return ""
+}
+
+func (v *StreamListView) onEnter() {
+	if name := v.selectedName(); name != "" {
+		v.ui.ShowStreamDetail(name)
 	}
 }
 
 func (v *StreamListView) viewMessages() {
-	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-		v.ui.ShowMessages(stream.Name)
+	if name := v.selectedName(); name != "" {
+		v.ui.ShowMessages(name)
 	}
 }
 
 func (v *StreamListView) viewMetricsGraph() {
-	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-		v.ui.ShowMetricsGraph(stream.Name)
+	if name := v.selectedName(); name != "" {
+		v.ui.ShowMetricsGraph(name)
 	}
 }
 
 func (v *StreamListView) editStream() {
-	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-		v.ui.ShowStreamEdit(stream.Name)
+	if name := v.selectedName(); name != "" {
+		v.ui.ShowStreamEdit(name)
 	}
 }
 
@@ -298,17 +745,15 @@ func (v *StreamListView) deleteStream() {
 		return
 	}
 
-	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-
+	if name := v.selectedName(); name != "" {
 		modal := components.ConfirmModal(
-			fmt.Sprintf("Delete stream '%s'?\nThis will delete all messages and consumers.", stream.Name),
+			fmt.Sprintf("Delete stream '%s'?\nThis will delete all messages and consumers.", name),
 			func() {
 				v.ui.CloseModal()
-				if err := v.ui.client.DeleteStream(stream.Name); err != nil {
+				if err := v.ui.client.DeleteStream(name); err != nil {
 					v.ui.ShowError(fmt.Sprintf("Failed to delete: %v", err))
 				} else {
+					v.ui.cache.invalidate(name)
 					v.Refresh()
 				}
 			},
@@ -327,17 +772,15 @@ func (v *StreamListView) purgeStream() {
 		return
 	}
 
-	row, _ := v.table.GetSelection()
-	if row > 0 && row <= len(v.streams) {
-		stream := v.streams[row-1]
-
+	if name := v.selectedName(); name != "" {
 		modal := components.ConfirmModal(
-			fmt.Sprintf("Purge all messages from stream '%s'?", stream.Name),
+			fmt.Sprintf("Purge all messages from stream '%s'?", name),
 			func() {
 				v.ui.CloseModal()
-				if err := v.ui.client.PurgeStream(stream.Name); err != nil {
+				if err := v.ui.client.PurgeStream(name); err != nil {
 					v.ui.ShowError(fmt.Sprintf("Failed to purge: %v", err))
 				} else {
+					v.ui.cache.invalidate(name)
 					v.Refresh()
 				}
 			},
@@ -351,12 +794,16 @@ func (v *StreamListView) purgeStream() {
 }
 
 func (v *StreamListView) updateDescribePanel(row int) {
-	if row <= 0 || row > len(v.streams) {
+	if row <= 0 || row > len(v.names) {
 		v.describePanel.SetText("[gray]Select a stream to view details[white]")
 		return
 	}
 
-	stream := v.streams[row-1]
+	stream, _ := v.ui.cache.stream(v.names[row-1])
+	if stream == nil {
+		v.describePanel.SetText(fmt.Sprintf("[yellow]%s[white]\n\n[gray]Loading...[white]", v.names[row-1]))
+		return
+	}
 
 	// Build describe text
 	var output strings.Builder
@@ -398,6 +845,27 @@ func formatNumber(n uint64) string {
 	return fmt.Sprintf("%d", n)
 }
 
+// formatOptionalTime formats a time as with formatTime, or "-" if it isn't set
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return formatTime(t)
+}
+
+// formatAge formats a duration in its largest whole unit, e.g. "12d"
+func formatAge(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+	return fmt.Sprintf("%ds", int(d.Seconds()))
+}
+
 func formatBytes(b uint64) string {
 	if b > 1024*1024*1024 {
 		return fmt.Sprintf("%.1fGB", float64(b)/(1024*1024*1024))
@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/shubhamrasal/n2s/internal/models"
+	"github.com/shubhamrasal/n2s/internal/nats"
+)
+
+// replicationEdge is a mirror or source relationship from an upstream to a stream
+type replicationEdge struct {
+	kind       string // mirror or source
+	downstream string
+	source     *models.StreamSource
+}
+
+// TopologyView draws which streams feed which across the account
+type TopologyView struct {
+	ui       *UIManager
+	flex     *tview.Flex
+	textView *tview.TextView
+}
+
+// NewTopologyView creates a new topology view
+func NewTopologyView(ui *UIManager) *TopologyView {
+	view := &TopologyView{
+		ui: ui,
+	}
+
+	view.textView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(false)
+
+	view.textView.SetBorder(true).
+		SetTitle(" Replication Topology ").
+		SetTitleAlign(tview.AlignCenter)
+
+	view.flex = tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(view.textView, 0, 1, true)
+
+	view.setupKeybindings()
+
+	return view
+}
+
+func (v *TopologyView) setupKeybindings() {
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEsc:
+			v.ui.ShowStreamList()
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 'r':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+// Refresh rebuilds the topology from the current stream list
+func (v *TopologyView) Refresh() {
+	if streams, ok := v.ui.cache.streamList(); ok {
+		v.textView.SetText(renderTopology(streams))
+		v.ui.footer.Update("r: Refresh  Esc: Back")
+		return
+	}
+
+	v.ui.refresh("topology", func(client *nats.Client) func() {
+		streams, err := client.ListStreams()
+
+		return func() {
+			if err != nil {
+				v.ui.ShowError(fmt.Sprintf("Failed to list streams: %v", err))
+				return
+			}
+
+			v.ui.cache.putStreamList(streams)
+			v.textView.SetText(renderTopology(streams))
+			v.ui.footer.Update("r: Refresh  Esc: Back")
+		}
+	})
+}
+
+// renderTopology draws a tree per upstream root, following mirrors and sources downstream
+func renderTopology(streams []*models.Stream) string {
+	local := make(map[string]bool, len(streams))
+	for _, stream := range streams {
+		local[stream.Name] = true
+	}
+
+	// Edges keyed by upstream node
+	downstream := make(map[string][]replicationEdge)
+	hasUpstream := make(map[string]bool)
+	for _, stream := range streams {
+		if m := stream.Config.Mirror; m != nil {
+			downstream[upstreamNode(m)] = append(downstream[upstreamNode(m)], replicationEdge{"mirror", stream.Name, m})
+			hasUpstream[stream.Name] = true
+		}
+		for _, s := range stream.Config.Sources {
+			downstream[upstreamNode(s)] = append(downstream[upstreamNode(s)], replicationEdge{"source", stream.Name, s})
+			hasUpstream[stream.Name] = true
+		}
+	}
+
+	if len(downstream) == 0 {
+		return fmt.Sprintf("[gray]No mirrors or sources configured across %d streams[white]", len(streams))
+	}
+
+	// Roots are upstreams that are not fed by anything themselves (including external ones)
+	var roots []string
+	for node := range downstream {
+		if !hasUpstream[node] {
+			roots = append(roots, node)
+		}
+	}
+	sort.Strings(roots)
+
+	var output strings.Builder
+	drawn := make(map[string]bool)
+
+	var draw func(node, prefix string, path map[string]bool)
+	draw = func(node, prefix string, path map[string]bool) {
+		edges := downstream[node]
+		sort.Slice(edges, func(i, j int) bool { return edges[i].downstream < edges[j].downstream })
+
+		for i, edge := range edges {
+			branch, childPrefix := "├─▶ ", "│   "
+			if i == len(edges)-1 {
+				branch, childPrefix = "└─▶ ", "    "
+			}
+
+			output.WriteString(fmt.Sprintf("%s%s[cyan]%s[white]  %s\n", prefix, branch, edge.downstream, describeEdge(edge)))
+
+			if path[edge.downstream] {
+				output.WriteString(fmt.Sprintf("%s%s[red](cycle)[white]\n", prefix, childPrefix))
+				continue
+			}
+			drawn[edge.downstream] = true
+			path[edge.downstream] = true
+			draw(edge.downstream, prefix+childPrefix, path)
+			delete(path, edge.downstream)
+		}
+	}
+
+	for _, root := range roots {
+		label := root
+		if !local[root] {
+			label += " [gray](external)[white]"
+		}
+		output.WriteString(fmt.Sprintf("[yellow]%s[white]\n", label))
+		drawn[root] = true
+		draw(root, "", map[string]bool{root: true})
+		output.WriteString("\n")
+	}
+
+	// Streams only reachable through a cycle have no root
+	var cyclic []string
+	for node := range downstream {
+		if !drawn[node] {
+			cyclic = append(cyclic, node)
+		}
+	}
+	sort.Strings(cyclic)
+	for _, node := range cyclic {
+		if drawn[node] {
+			continue
+		}
+		output.WriteString(fmt.Sprintf("[red]%s (cycle)[white]\n", node))
+		drawn[node] = true
+		draw(node, "", map[string]bool{node: true})
+		output.WriteString("\n")
+	}
+
+	unreplicated := 0
+	for _, stream := range streams {
+		if !drawn[stream.Name] {
+			unreplicated++
+		}
+	}
+	output.WriteString(fmt.Sprintf("[gray]%d streams without mirrors or sources[white]\n", unreplicated))
+
+	return output.String()
+}
+
+// upstreamNode names the upstream of a mirror or source, qualified by domain or API prefix if external
+func upstreamNode(source *models.StreamSource) string {
+	switch {
+	case source.Domain != "":
+		return source.Name + "@" + source.Domain
+	case source.APIPrefix != "":
+		return source.Name + "@" + source.APIPrefix
+	}
+	return source.Name
+}
+
+func describeEdge(edge replicationEdge) string {
+	parts := []string{edge.kind}
+	if edge.source.FilterSubject != "" {
+		parts = append(parts, "filter "+edge.source.FilterSubject)
+	}
+	for _, t := range edge.source.SubjectTransforms {
+		parts = append(parts, fmt.Sprintf("%s → %s", t.Source, t.Destination))
+	}
+
+	return fmt.Sprintf("(%s)  %s", strings.Join(parts, ", "), sourceStatus(edge.source))
+}
+
+// sourceStatus formats lag, last activity and error of a mirror or source, highlighting problems
+func sourceStatus(source *models.StreamSource) string {
+	if source.Error != "" {
+		return fmt.Sprintf("[red]error: %s[white]", tview.Escape(source.Error))
+	}
+
+	lagColor := "green"
+	if source.Lag > 0 {
+		lagColor = "yellow"
+	}
+
+	active := "[red]never active[white]"
+	if source.Active >= 0 {
+		active = fmt.Sprintf("active %s ago", source.Active.Round(time.Millisecond))
+		if source.Active > time.Minute {
+			active = "[yellow]" + active + "[white]"
+		}
+	}
+
+	return fmt.Sprintf("[%s]lag %s[white]  %s", lagColor, formatNumber(source.Lag), active)
+}
+
+// GetPrimitive returns the primitive for this view
+func (v *TopologyView) GetPrimitive() tview.Primitive {
+	return v.flex
+}
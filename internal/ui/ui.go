@@ -2,11 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 	"github.com/shubhamrasal/n2s/internal/config"
+	"github.com/shubhamrasal/n2s/internal/models"
 	"github.com/shubhamrasal/n2s/internal/nats"
 	"github.com/shubhamrasal/n2s/internal/plugins"
 	"github.com/shubhamrasal/n2s/internal/ui/components"
@@ -15,7 +18,9 @@ import (
 // UIManager manages the application UI
 type UIManager struct {
 	app           *tview.Application
-	client        *nats.Client
+	client        *nats.Client // Replaced on the UI thread, see sharedClient for other goroutines
+	clientMu      sync.Mutex   // Guards client replacement against sharedClient
+	clientGen     int          // Incremented when the client is replaced
 	config        *config.Config
 	pluginManager *plugins.Manager
 	readOnly      bool
@@ -35,12 +40,28 @@ type UIManager struct {
 	queryBuilderView   *QueryBuilderView
 	metricsGraphView   *MetricsGraphView
 	streamEditView     *StreamEditView
+	bulkEditView       *BulkEditView
+	consumerQueryView  *ConsumerQueryView
+	auditView          *AuditView
 	consumerEditView   *ConsumerEditView
+	eventsView         *EventsView
+	accountView        *AccountView
+	serversView        *ServersView
+	connectionsView    *ConnectionsView
+	connLogView        *ConnLogView
+	topologyView       *TopologyView
 	helpView           *HelpView
 
 	// State
-	currentPage  string
-	updateTicker *time.Ticker
+	currentPage    string
+	updateTicker   *time.Ticker
+	advisories     *advisoryLog
+	stopAdvisories func()
+	accountDomain  string // JetStream domain reported by the server
+	cancelRefresh  func() // Cancels the background refresh in flight, nil when idle
+	refreshGen     int    // Incremented for every refresh so stale results are dropped
+	cache          *resourceCache
+	configErrors   []string // Invalid settings found while building the views, shown on start
 }
 
 // NewUIManager creates a new UI manager
@@ -52,11 +73,15 @@ func NewUIManager(app *tview.Application, client *nats.Client, cfg *config.Confi
 		pluginManager: pluginMgr,
 		readOnly:      readOnly,
 		pages:         tview.NewPages(),
+		advisories:    &advisoryLog{},
+		// Half the refresh interval, so the auto-refresh still fetches every tick
+		cache:         newResourceCache(cfg.GetRefreshInterval() / 2),
 	}
 
 	ui.initComponents()
 	ui.setupPages()
 	ui.setupKeybindings()
+	ui.client.OnConnectionEvent(ui.onConnectionEvent)
 
 	return ui
 }
@@ -77,7 +102,16 @@ func (ui *UIManager) initComponents() {
 	ui.queryBuilderView = NewQueryBuilderView(ui)
 	ui.metricsGraphView = NewMetricsGraphView(ui)
 	ui.streamEditView = NewStreamEditView(ui)
+	ui.bulkEditView = NewBulkEditView(ui)
+	ui.consumerQueryView = NewConsumerQueryView(ui)
+	ui.auditView = NewAuditView(ui)
 	ui.consumerEditView = NewConsumerEditView(ui)
+	ui.eventsView = NewEventsView(ui)
+	ui.accountView = NewAccountView(ui)
+	ui.serversView = NewServersView(ui)
+	ui.connectionsView = NewConnectionsView(ui)
+	ui.connLogView = NewConnLogView(ui)
+	ui.topologyView = NewTopologyView(ui)
 	ui.helpView = NewHelpView(ui)
 }
 
@@ -92,13 +126,22 @@ func (ui *UIManager) setupPages() {
 	ui.pages.AddPage("query-builder", ui.queryBuilderView.GetPrimitive(), true, false)
 	ui.pages.AddPage("metrics-graph", ui.metricsGraphView.GetPrimitive(), true, false)
 	ui.pages.AddPage("stream-edit", ui.streamEditView.GetPrimitive(), true, false)
+	ui.pages.AddPage("bulk-edit", ui.bulkEditView.GetPrimitive(), true, false)
+	ui.pages.AddPage("consumer-query", ui.consumerQueryView.GetPrimitive(), true, false)
 	ui.pages.AddPage("consumer-edit", ui.consumerEditView.GetPrimitive(), true, false)
+	ui.pages.AddPage("events", ui.eventsView.GetPrimitive(), true, false)
+	ui.pages.AddPage("account", ui.accountView.GetPrimitive(), true, false)
+	ui.pages.AddPage("servers", ui.serversView.GetPrimitive(), true, false)
+	ui.pages.AddPage("connections", ui.connectionsView.GetPrimitive(), true, false)
+	ui.pages.AddPage("connection-log", ui.connLogView.GetPrimitive(), true, false)
+	ui.pages.AddPage("audit", ui.auditView.GetPrimitive(), true, false)
+	ui.pages.AddPage("topology", ui.topologyView.GetPrimitive(), true, false)
 }
 
 func (ui *UIManager) setupKeybindings() {
 	ui.app.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
-		// Don't intercept global keys when in query builder (user is typing)
-		if ui.currentPage == "query-builder" {
+		// Don't intercept global keys when in query builder or bulk edit (user is typing)
+		if ui.currentPage == "query-builder" || ui.currentPage == "bulk-edit" || ui.currentPage == "consumer-query" {
 			// Allow Ctrl+C and ? only
 			if event.Key() == tcell.KeyCtrlC {
 				ui.app.Stop()
@@ -111,6 +154,15 @@ func (ui *UIManager) setupKeybindings() {
 			return event
 		}
 		
+		// Don't intercept typing in input fields (filters, dialogs)
+		if _, typing := ui.app.GetFocus().(*tview.InputField); typing {
+			if event.Key() == tcell.KeyCtrlC {
+				ui.app.Stop()
+				return nil
+			}
+			return event
+		}
+
 		// Global keybindings for other views
 		switch event.Key() {
 		case tcell.KeyCtrlC:
@@ -137,39 +189,72 @@ func (ui *UIManager) Start() error {
 	// Create main layout
 	layout := tview.NewFlex().
 		SetDirection(tview.FlexRow).
-		AddItem(ui.header, 1, 0, false).
+		AddItem(ui.header, 2, 0, false).
 		AddItem(ui.pages, 0, 1, true).
 		AddItem(ui.footer, 1, 0, false)
 
+	// Collect advisories in the background for the events view
+	ui.subscribeAdvisories()
+
 	// Start auto-refresh ticker
 	ui.updateTicker = time.NewTicker(ui.config.GetRefreshInterval())
 	go ui.autoRefreshLoop()
 
+	// Measure connection health (RTT, traffic) for the header
+	go ui.healthLoop()
+
 	// Set root and run
 	ui.app.SetRoot(layout, true).SetFocus(ui.pages)
+	if len(ui.configErrors) > 0 {
+		ui.ShowError(strings.Join(ui.configErrors, "\n"))
+	}
 	return ui.app.Run()
 }
 
 func (ui *UIManager) updateHeader() {
-	status := ui.header.UpdateStatus(ui.client.IsConnected())
+	status := ui.header.UpdateStatus(ui.client.Status(), ui.recentConnEvent())
 	configSource := ui.config.GetConfigSourceDescription()
+	domain := ui.accountDomain
+	if domain == "" {
+		domain = ui.config.CurrentContext().JetStreamDomain
+	}
+	ui.header.SetDomain(domain)
 	ui.header.UpdateWithSource(ui.config.CurrentContextName(), status, configSource, ui.readOnly)
 }
 
+// autoRefreshLoop periodically refreshes the header and the current view. The UI thread
+// only starts background workers, so a slow server never blocks input.
 func (ui *UIManager) autoRefreshLoop() {
 	for range ui.updateTicker.C {
 		ui.app.QueueUpdateDraw(func() {
-			ui.updateHeader()
+			ui.refreshAccountUsage()
+
+			// Let a slow refresh finish instead of restarting it on every tick
+			if ui.refreshing() {
+				return
+			}
+
 			// Refresh current view (skip messages - manual refresh only)
 			switch ui.currentPage {
 			case "streams":
-				ui.streamListView.Refresh()
+				if ui.streamListView.refreshDue() {
+					ui.streamListView.Refresh()
+				}
 			case "stream-detail":
 				ui.streamDetailView.Refresh()
 			case "consumer-detail":
 				ui.consumerDetailView.Refresh()
 			case "describe":
 				ui.describeView.Refresh()
+			case "events":
+				ui.eventsView.Refresh()
+			case "servers":
+				ui.serversView.Refresh()
+			case "connections":
+				ui.connectionsView.Refresh()
+			case "topology":
+				ui.topologyView.Refresh()
+			// Account view is updated with the header usage
 			// Messages view excluded from auto-refresh (expensive operation)
 			}
 		})
@@ -224,11 +309,78 @@ func (ui *UIManager) ShowDescribe(streamName string) {
 	ui.app.SetFocus(ui.describeView.GetPrimitive())
 }
 
+// ShowMessageSequence displays the message browser with a specific message selected
+func (ui *UIManager) ShowMessageSequence(streamName string, seq uint64) {
+	ui.ShowMessages(streamName)
+	ui.messageView.ShowSequence(seq)
+}
+
+// ShowEvents displays the JetStream advisory timeline
+func (ui *UIManager) ShowEvents() {
+	ui.currentPage = "events"
+	ui.pages.SwitchToPage("events")
+	ui.eventsView.Refresh()
+	ui.app.SetFocus(ui.eventsView.table)
+}
+
+// ShowAccount displays the JetStream account usage dashboard
+func (ui *UIManager) ShowAccount() {
+	ui.currentPage = "account"
+	ui.pages.SwitchToPage("account")
+	ui.accountView.Refresh()
+	ui.app.SetFocus(ui.accountView.GetPrimitive())
+}
+
+// ShowServers displays the server monitoring view (requires system account access)
+func (ui *UIManager) ShowServers() {
+	ui.currentPage = "servers"
+	ui.pages.SwitchToPage("servers")
+	ui.serversView.collapseDetail()
+	ui.serversView.Refresh()
+}
+
+// ShowConnections displays client connections, optionally only those receiving from a stream
+func (ui *UIManager) ShowConnections(streamName string) {
+	ui.currentPage = "connections"
+	ui.pages.SwitchToPage("connections")
+	ui.connectionsView.SetStream(streamName)
+	ui.app.SetFocus(ui.connectionsView.table)
+}
+
+// ShowTopology displays the mirror/source replication topology
+func (ui *UIManager) ShowTopology() {
+	ui.currentPage = "topology"
+	ui.pages.SwitchToPage("topology")
+	ui.topologyView.Refresh()
+	ui.app.SetFocus(ui.topologyView.GetPrimitive())
+}
+
+// ShowConnectionLog displays the event log of our own NATS connection
+func (ui *UIManager) ShowConnectionLog() {
+	ui.currentPage = "connection-log"
+	ui.pages.SwitchToPage("connection-log")
+	ui.connLogView.Refresh()
+	ui.app.SetFocus(ui.connLogView.table)
+}
+
+// ShowAudit displays the audit log of mutating operations
+func (ui *UIManager) ShowAudit() {
+	ui.currentPage = "audit"
+	ui.pages.SwitchToPage("audit")
+	ui.auditView.Refresh()
+	ui.app.SetFocus(ui.auditView.table)
+}
+
 // ShowQueryBuilder displays the bulk operations query builder
 func (ui *UIManager) ShowQueryBuilder() {
 	ui.queryBuilderView.Show()
 }
 
+// ShowConsumerQuery displays the query builder's consumer mode
+func (ui *UIManager) ShowConsumerQuery() {
+	ui.consumerQueryView.Show()
+}
+
 // ShowMetricsGraph displays metrics graphs for a stream
 func (ui *UIManager) ShowMetricsGraph(streamName string) {
 	ui.currentPage = "metrics-graph"
@@ -243,6 +395,12 @@ func (ui *UIManager) ShowStreamEdit(streamName string) {
 	ui.streamEditView.Show()
 }
 
+// ShowBulkEdit displays the bulk edit form for streams matched in the query builder
+func (ui *UIManager) ShowBulkEdit(streams []*models.Stream) {
+	ui.bulkEditView.SetStreams(streams)
+	ui.bulkEditView.Show()
+}
+
 // ShowConsumerEdit displays the consumer edit form
 func (ui *UIManager) ShowConsumerEdit(streamName, consumerName string) {
 	ui.consumerEditView.SetConsumer(streamName, consumerName)
@@ -297,6 +455,11 @@ func (ui *UIManager) SwitchContext(contextName string) error {
 	}
 
 	// Close old connection
+	if ui.stopAdvisories != nil {
+		ui.stopAdvisories()
+		ui.stopAdvisories = nil
+	}
+	ui.client.OnConnectionEvent(nil)
 	ui.client.Close()
 
 	// Create new client with new context
@@ -305,9 +468,76 @@ func (ui *UIManager) SwitchContext(contextName string) error {
 		return fmt.Errorf("failed to connect to new context: %w", err)
 	}
 
+	ui.clientMu.Lock()
 	ui.client = newClient
+	ui.clientGen++
+	ui.clientMu.Unlock()
+	ui.client.OnConnectionEvent(ui.onConnectionEvent)
+	ui.accountDomain = ""
 	ui.updateHeader()
 
+	// Advisories and cached resources belong to the old account, start afresh
+	ui.advisories.clear()
+	ui.cache.clear()
+	ui.subscribeAdvisories()
+
 	return nil
 }
 
+// sharedClient returns the current client and its generation, for goroutines other
+// than the UI thread. The generation changes when a context switch replaces the client.
+func (ui *UIManager) sharedClient() (*nats.Client, int) {
+	ui.clientMu.Lock()
+	defer ui.clientMu.Unlock()
+	return ui.client, ui.clientGen
+}
+
+// subscribeAdvisories (re)subscribes to JetStream advisories on the current client.
+// Failures are not fatal: the events view simply stays empty.
+func (ui *UIManager) subscribeAdvisories() {
+	if ui.stopAdvisories != nil {
+		ui.stopAdvisories()
+		ui.stopAdvisories = nil
+	}
+
+	stop, err := ui.client.SubscribeAdvisories(ui.onAdvisory)
+	if err == nil {
+		ui.stopAdvisories = stop
+	}
+}
+
+// onAdvisory is called from the advisory subscription for every JetStream advisory
+func (ui *UIManager) onAdvisory(event *models.Event) {
+	ui.advisories.add(event)
+
+	if invalidatesCache(event) {
+		ui.app.QueueUpdateDraw(func() {
+			ui.cache.invalidate(event.Stream)
+		})
+	}
+}
+
+// onConnectionEvent is called from nats.go callback goroutines for every connection event
+func (ui *UIManager) onConnectionEvent(event models.ConnEvent) {
+	ui.app.QueueUpdateDraw(func() {
+		ui.updateHeader()
+		if ui.currentPage == "connection-log" {
+			ui.connLogView.Refresh()
+		}
+	})
+}
+
+// recentConnEvent describes the latest connection problem or recovery of the last 30 seconds
+func (ui *UIManager) recentConnEvent() string {
+	events := ui.client.ConnectionEvents()
+	if len(events) == 0 {
+		return ""
+	}
+
+	last := events[len(events)-1]
+	age := time.Since(last.Time)
+	if last.Type == models.ConnEventConnected || age > 30*time.Second {
+		return ""
+	}
+	return fmt.Sprintf("%s %s ago", last.Type, formatDuration(age.Truncate(time.Second)+time.Second))
+}